@@ -0,0 +1,200 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixAccountAudit() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixAccountAuditRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the account to audit. All accounts are audited if not set.",
+			},
+			"run_audit": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Run a new audit instead of returning the results of the last periodic audit.",
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+				Description:  "Maximum number of accounts audited at the same time when 'run_audit' is true.",
+			},
+			"passed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether all the audited accounts passed the audit.",
+			},
+			"failed_account_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the accounts that failed the audit.",
+			},
+			"accounts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Audit results of the accounts.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Account name.",
+						},
+						"cloud_type": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Type of cloud service provider.",
+						},
+						"passed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the account passed the audit.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Audit comment returned by the controller.",
+						},
+						"findings": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Issues found by the audit.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Description: "Type of the issue: 'missing_permission', 'expired_credentials', " +
+											"'invalid_credentials', 'role_trust' or 'other'.",
+									},
+									"permission": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Cloud permission missing from the account. Only set for 'missing_permission' findings.",
+									},
+									"message": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Part of the audit comment describing the issue.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixAccountAuditRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	accountName := d.Get("account_name").(string)
+
+	accounts, err := client.ListAccounts()
+	if err != nil {
+		return diag.Errorf("failed to list Aviatrix accounts: %s", err)
+	}
+	if accountName != "" {
+		var found []goaviatrix.Account
+		for _, account := range accounts {
+			if account.AccountName == accountName {
+				found = append(found, account)
+			}
+		}
+		if len(found) == 0 {
+			return diag.Errorf("couldn't find Aviatrix account %s", accountName)
+		}
+		accounts = found
+	}
+
+	// Errors of the audits run now, the audit records may not reflect them yet
+	runErrs := make([]error, len(accounts))
+	if d.Get("run_audit").(bool) {
+		var tasks []func() error
+		for i := range accounts {
+			i := i
+			tasks = append(tasks, func() error {
+				log.Printf("[INFO] Auditing Aviatrix account: %s", accounts[i].AccountName)
+				runErrs[i] = client.RunAccountAudit(ctx, &accounts[i])
+				return nil
+			})
+		}
+		_ = runConcurrently(d.Get("max_concurrency").(int), tasks)
+	}
+
+	records, err := client.GetAccountAuditRecords(ctx)
+	if err != nil {
+		return diag.Errorf("failed to get Aviatrix account audit records: %s", err)
+	}
+	recordsByName := make(map[string]goaviatrix.AccountAuditRecord)
+	for _, record := range records {
+		recordsByName[record.AccountName] = record
+	}
+
+	passed := true
+	failedAccountNames := []string{}
+	var results []map[string]interface{}
+	for i, account := range accounts {
+		record, ok := recordsByName[account.AccountName]
+		if !ok {
+			record = goaviatrix.AccountAuditRecord{AccountName: account.AccountName, Status: "Pass"}
+		}
+		if runErrs[i] != nil && record.Passed() {
+			record.Status = "Fail"
+			record.Comment = runErrs[i].Error()
+		}
+
+		var findings []map[string]interface{}
+		for _, finding := range record.Findings() {
+			findings = append(findings, map[string]interface{}{
+				"type":       finding.Type,
+				"permission": finding.Permission,
+				"message":    finding.Message,
+			})
+		}
+		if !record.Passed() {
+			passed = false
+			failedAccountNames = append(failedAccountNames, account.AccountName)
+		}
+		results = append(results, map[string]interface{}{
+			"account_name": account.AccountName,
+			"cloud_type":   account.CloudType,
+			"passed":       record.Passed(),
+			"comment":      record.Comment,
+			"findings":     findings,
+		})
+	}
+
+	d.Set("passed", passed)
+	if err := d.Set("failed_account_names", failedAccountNames); err != nil {
+		return diag.Errorf("failed to set failed_account_names: %s", err)
+	}
+	if err := d.Set("accounts", results); err != nil {
+		return diag.Errorf("failed to set accounts: %s", err)
+	}
+
+	if accountName != "" {
+		d.SetId(fmt.Sprintf("account-audit-%s", accountName))
+	} else {
+		d.SetId("account-audit")
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package aviatrix
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixAccountUserPermissions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAviatrixAccountUserPermissionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the account user.",
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+				Description:  "Maximum number of RBAC groups looked up at the same time.",
+			},
+			"rbac_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "RBAC groups the user is a member of.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "RBAC group name.",
+						},
+						"local_login": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether members of the group can bypass LDAP/MFA for Duo login.",
+						},
+						"permissions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Permissions of the group.",
+						},
+						"access_accounts": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Access accounts of the group.",
+						},
+					},
+				},
+			},
+			"account_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Accounts the user has any permission on.",
+			},
+			"permission_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Permissions the user has on any account.",
+			},
+			"effective_permissions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Permissions the user has on each account.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Account name.",
+						},
+						"permission_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Permission name.",
+						},
+						"rbac_groups": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "RBAC groups granting the permission on the account.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixAccountUserPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*goaviatrix.Client)
+
+	userName := d.Get("user_name").(string)
+
+	_, err := client.GetAccountUser(&goaviatrix.AccountUser{UserName: userName})
+	if err != nil {
+		if err == goaviatrix.ErrNotFound {
+			return fmt.Errorf("couldn't find Aviatrix account user %s", userName)
+		}
+		return fmt.Errorf("failed to get Aviatrix account user: %s", err)
+	}
+
+	groups, err := client.ListPermissionGroupDetails()
+	if err != nil {
+		return fmt.Errorf("failed to list Aviatrix RBAC groups: %s", err)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].GroupName < groups[j].GroupName
+	})
+
+	// Grants of the groups the user is a member of, nil for the other groups
+	grants := make([]*goaviatrix.RbacGroupGrant, len(groups))
+	var tasks []func() error
+	for i := range groups {
+		i := i
+		tasks = append(tasks, func() error {
+			group := groups[i]
+			users, err := client.ListRbacGroupUsers(group.GroupName)
+			if err != nil {
+				return fmt.Errorf("failed to list users of RBAC group %s: %v", group.GroupName, err)
+			}
+			if !goaviatrix.Contains(users, userName) {
+				return nil
+			}
+
+			permissions, err := client.ListRbacGroupPermissions(group.GroupName)
+			if err != nil {
+				return fmt.Errorf("failed to list permissions of RBAC group %s: %v", group.GroupName, err)
+			}
+			accessAccounts, err := client.ListRbacGroupAccessAccounts(group.GroupName)
+			if err != nil {
+				return fmt.Errorf("failed to list access accounts of RBAC group %s: %v", group.GroupName, err)
+			}
+
+			grant := &goaviatrix.RbacGroupGrant{
+				GroupName:      group.GroupName,
+				LocalLogin:     group.LocalLogin,
+				AccessAccounts: accessAccounts,
+			}
+			for _, permission := range permissions {
+				grant.Permissions = append(grant.Permissions, permission.Name)
+			}
+			sort.Strings(grant.Permissions)
+			sort.Strings(grant.AccessAccounts)
+			grants[i] = grant
+			return nil
+		})
+	}
+	if err := runConcurrently(d.Get("max_concurrency").(int), tasks); err != nil {
+		return fmt.Errorf("failed to get the RBAC groups of Aviatrix account user %s: %s", userName, err)
+	}
+
+	accounts, err := client.ListAccounts()
+	if err != nil {
+		return fmt.Errorf("failed to list Aviatrix accounts: %s", err)
+	}
+	var accountNames []string
+	for _, account := range accounts {
+		accountNames = append(accountNames, account.AccountName)
+	}
+
+	var memberGrants []goaviatrix.RbacGroupGrant
+	var rbacGroups []map[string]interface{}
+	for _, grant := range grants {
+		if grant == nil {
+			continue
+		}
+		memberGrants = append(memberGrants, *grant)
+		rbacGroups = append(rbacGroups, map[string]interface{}{
+			"group_name":      grant.GroupName,
+			"local_login":     grant.LocalLogin,
+			"permissions":     grant.Permissions,
+			"access_accounts": grant.AccessAccounts,
+		})
+	}
+
+	userAccountNames := []string{}
+	permissionNames := []string{}
+	var effectivePermissions []map[string]interface{}
+	for _, permission := range goaviatrix.EffectivePermissions(memberGrants, accountNames) {
+		if !goaviatrix.Contains(userAccountNames, permission.AccountName) {
+			userAccountNames = append(userAccountNames, permission.AccountName)
+		}
+		if !goaviatrix.Contains(permissionNames, permission.PermissionName) {
+			permissionNames = append(permissionNames, permission.PermissionName)
+		}
+		effectivePermissions = append(effectivePermissions, map[string]interface{}{
+			"account_name":    permission.AccountName,
+			"permission_name": permission.PermissionName,
+			"rbac_groups":     permission.GroupNames,
+		})
+	}
+	sort.Strings(permissionNames)
+
+	if err := d.Set("rbac_groups", rbacGroups); err != nil {
+		return fmt.Errorf("failed to set rbac_groups: %s", err)
+	}
+	if err := d.Set("account_names", userAccountNames); err != nil {
+		return fmt.Errorf("failed to set account_names: %s", err)
+	}
+	if err := d.Set("permission_names", permissionNames); err != nil {
+		return fmt.Errorf("failed to set permission_names: %s", err)
+	}
+	if err := d.Set("effective_permissions", effectivePermissions); err != nil {
+		return fmt.Errorf("failed to set effective_permissions: %s", err)
+	}
+
+	d.SetId(userName)
+	return nil
+}
@@ -0,0 +1,92 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixAccountUserPermissions_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "data.aviatrix_account_user_permissions.foo"
+
+	skipAcc := os.Getenv("SKIP_DATA_ACCOUNT_USER_PERMISSIONS")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source Account User Permissions test as SKIP_DATA_ACCOUNT_USER_PERMISSIONS is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preAccountCheck(t, ". Set SKIP_DATA_ACCOUNT_USER_PERMISSIONS to yes to skip Data Source Account User Permissions tests")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAviatrixAccountUserPermissionsConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixAccountUserPermissions(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rbac_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rbac_groups.0.group_name", fmt.Sprintf("tf-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "effective_permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "effective_permissions.0.account_name", fmt.Sprintf("tf-testing-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "effective_permissions.0.permission_name", "all_gateway_write"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAviatrixAccountUserPermissionsConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test" {
+	account_name       = "tf-testing-%[1]s"
+	cloud_type         = 1
+	aws_account_number = "%[2]s"
+	aws_iam            = "false"
+	aws_access_key     = "%[3]s"
+	aws_secret_key     = "%[4]s"
+}
+resource "aviatrix_account_user" "test" {
+	username = "tf-user-%[1]s"
+	email    = "abc@xyz.com"
+	password = "Password-1234"
+}
+resource "aviatrix_rbac_group" "test" {
+	group_name         = "tf-%[1]s"
+	manage_permissions = true
+	permissions        = ["all_gateway_write"]
+}
+resource "aviatrix_rbac_group_user_attachment" "test" {
+	group_name = aviatrix_rbac_group.test.group_name
+	user_name  = aviatrix_account_user.test.username
+}
+resource "aviatrix_rbac_group_access_account_attachment" "test" {
+	group_name          = aviatrix_rbac_group.test.group_name
+	access_account_name = aviatrix_account.test.account_name
+}
+data "aviatrix_account_user_permissions" "foo" {
+	user_name = aviatrix_account_user.test.username
+
+	depends_on = [
+		aviatrix_rbac_group_user_attachment.test,
+		aviatrix_rbac_group_access_account_attachment.test,
+	]
+}
+	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"))
+}
+
+func testAccDataSourceAviatrixAccountUserPermissions(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no resource called %s", name)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,170 @@
+package aviatrix
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixAppDomainResources() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixAppDomainResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"uuid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ExactlyOneOf: []string{"uuid", "selector"},
+				Description:  "UUID of an existing App Domain to expand.",
+			},
+			"selector": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"match_expressions": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     appDomainMatchExpressionSchema(),
+						},
+					},
+				},
+				ExactlyOneOf: []string{"uuid", "selector"},
+				Description:  "App Domain selector to preview without creating an App Domain.",
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of resources the App Domain resolves to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the resource.",
+						},
+						"res_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource name.",
+						},
+						"account_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Account ID of the resource.",
+						},
+						"account_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Account name of the resource.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region of the resource.",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Zone of the resource.",
+						},
+						"vpc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "VPC ID of the resource.",
+						},
+						"cidrs": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "CIDRs of the resource.",
+						},
+					},
+				},
+			},
+			"cidrs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Sorted list of unique CIDRs of all resources the App Domain resolves to.",
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixAppDomainResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	var resources []*goaviatrix.AppDomainResource
+	var err error
+	uuid := d.Get("uuid").(string)
+	if uuid != "" {
+		resources, err = client.GetAppDomainResources(ctx, uuid)
+		if err != nil {
+			return diag.Errorf("failed to get App Domain resources: %s", err)
+		}
+	} else {
+		selector, err := marshalAppDomainSelector(d.Get("selector.0.match_expressions").([]interface{}))
+		if err != nil {
+			return diag.Errorf("invalid App Domain selector: %s", err)
+		}
+		appDomain := &goaviatrix.AppDomain{
+			Selector: *selector,
+		}
+		resources, err = client.PreviewAppDomainResources(ctx, appDomain)
+		if err != nil {
+			return diag.Errorf("failed to preview App Domain resources: %s", err)
+		}
+	}
+
+	var result []map[string]interface{}
+	cidrSet := make(map[string]bool)
+	for _, res := range resources {
+		result = append(result, map[string]interface{}{
+			"type":         res.Type,
+			"res_id":       res.ResId,
+			"name":         res.Name,
+			"account_id":   res.AccountId,
+			"account_name": res.AccountName,
+			"region":       res.Region,
+			"zone":         res.Zone,
+			"vpc_id":       res.VpcId,
+			"cidrs":        res.CIDRs,
+		})
+		for _, cidr := range res.CIDRs {
+			cidrSet[cidr] = true
+		}
+	}
+
+	var cidrs []string
+	for cidr := range cidrSet {
+		cidrs = append(cidrs, cidr)
+	}
+	sort.Strings(cidrs)
+
+	if err := d.Set("resources", result); err != nil {
+		return diag.Errorf("failed to set resources: %s", err)
+	}
+	if err := d.Set("cidrs", cidrs); err != nil {
+		return diag.Errorf("failed to set cidrs: %s", err)
+	}
+
+	if uuid != "" {
+		d.SetId(uuid)
+	} else {
+		d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixAppDomainResources_basic(t *testing.T) {
+	skipAcc := os.Getenv("SKIP_DATA_APP_DOMAIN_RESOURCES")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source App Domain Resources test as SKIP_DATA_APP_DOMAIN_RESOURCES is set")
+	}
+	resourceName := "data.aviatrix_app_domain_resources.test"
+	previewName := "data.aviatrix_app_domain_resources.preview"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProvidersVersionValidation,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAppDomainResourcesBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixAppDomainResources(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "uuid", "aviatrix_app_domain.test", "uuid"),
+					resource.TestCheckResourceAttrSet(resourceName, "resources.#"),
+					testAccDataSourceAviatrixAppDomainResources(previewName),
+					resource.TestCheckResourceAttrSet(previewName, "resources.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAppDomainResourcesBasic() string {
+	return `
+resource "aviatrix_app_domain" "test" {
+	name = "test-app-domain"
+
+	selector {
+		match_expressions {
+			cidr = "11.0.0.0/16"
+		}
+	}
+}
+
+data "aviatrix_app_domain_resources" "test" {
+	uuid = aviatrix_app_domain.test.uuid
+}
+
+data "aviatrix_app_domain_resources" "preview" {
+	selector {
+		match_expressions {
+			type   = "vpc"
+			region = "us-west-2"
+		}
+	}
+}
+`
+}
+
+func testAccDataSourceAviatrixAppDomainResources(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
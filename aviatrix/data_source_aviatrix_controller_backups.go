@@ -0,0 +1,121 @@
+package aviatrix
+
+import (
+	"context"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixControllerBackups() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixControllerBackupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cloud_type": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Cloud type of the backup location.",
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Access account of the backup location.",
+			},
+			"bucket_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Bucket of the backup location for AWS, AWSGov, GCP and OCI.",
+			},
+			"storage_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Storage account of the backup location for Azure.",
+			},
+			"container_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Container of the backup location for Azure.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Region of the backup location for Azure and OCI.",
+			},
+			"latest_file_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "File name of the newest backup.",
+			},
+			"backups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Backups in the backup location, newest first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "File name of the backup.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation time of the backup.",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Size of the backup in bytes.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixControllerBackupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	backupConfig, err := client.GetCloudnBackupConfig()
+	if err != nil {
+		return diag.Errorf("failed to read controller backup config: %s", err)
+	}
+	if backupConfig.BackupConfiguration != "yes" {
+		return diag.Errorf("controller backups are not enabled, please enable 'backup_configuration' in aviatrix_controller_config first")
+	}
+
+	backups, err := client.ListControllerBackups(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list Aviatrix controller backups: %s", err)
+	}
+
+	var latestFileName string
+	var results []map[string]interface{}
+	for _, backup := range backups {
+		if latestFileName == "" {
+			latestFileName = backup.FileName
+		}
+		results = append(results, map[string]interface{}{
+			"file_name":  backup.FileName,
+			"created_at": backup.CreatedAt,
+			"size":       backup.Size,
+		})
+	}
+
+	d.Set("cloud_type", backupConfig.BackupCloudType)
+	d.Set("account_name", backupConfig.BackupAccountName)
+	d.Set("bucket_name", backupConfig.BackupBucketName)
+	d.Set("storage_name", backupConfig.BackupStorageName)
+	d.Set("container_name", backupConfig.BackupContainerName)
+	d.Set("region", backupConfig.BackupRegion)
+	d.Set("latest_file_name", latestFileName)
+	if err := d.Set("backups", results); err != nil {
+		return diag.Errorf("failed to set backups: %s", err)
+	}
+
+	d.SetId("controller-backups")
+	return nil
+}
@@ -0,0 +1,55 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixControllerBackups_basic(t *testing.T) {
+	resourceName := "data.aviatrix_controller_backups.foo"
+
+	skipAcc := os.Getenv("SKIP_DATA_CONTROLLER_BACKUPS")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source Controller Backups test as SKIP_DATA_CONTROLLER_BACKUPS is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAviatrixControllerBackupsConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixControllerBackups(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "account_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "latest_file_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "latest_file_name", resourceName, "backups.0.file_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAviatrixControllerBackupsConfigBasic() string {
+	return `
+data "aviatrix_controller_backups" "foo" {
+}
+	`
+}
+
+func testAccDataSourceAviatrixControllerBackups(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
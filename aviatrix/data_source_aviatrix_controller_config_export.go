@@ -0,0 +1,65 @@
+package aviatrix
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixControllerConfigExport() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixControllerConfigExportRead,
+
+		Schema: map[string]*schema.Schema{
+			"sections": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(goaviatrix.ControllerConfigExportSections, false),
+				},
+				Description: "Sections of the controller configuration to export. Defaults to all the sections.",
+			},
+			"json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Controller configuration as a JSON document with sorted keys and lists.",
+			},
+			"sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 hash of the JSON document.",
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixControllerConfigExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	sections := goaviatrix.ControllerConfigExportSections
+	if v := getStringSet(d, "sections"); len(v) != 0 {
+		sections = v
+	}
+
+	export, err := client.ExportControllerConfig(ctx, sections)
+	if err != nil {
+		return diag.Errorf("failed to export controller configuration: %s", err)
+	}
+
+	b, err := goaviatrix.CanonicalJSON(export)
+	if err != nil {
+		return diag.Errorf("failed to encode controller configuration: %s", err)
+	}
+	hash := fmt.Sprintf("%x", sha256.Sum256(b))
+
+	d.Set("json", string(b))
+	d.Set("sha256", hash)
+	d.SetId(hash)
+	return nil
+}
@@ -0,0 +1,59 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixControllerConfigExport_basic(t *testing.T) {
+	resourceName := "data.aviatrix_controller_config_export.foo"
+
+	skipAcc := os.Getenv("SKIP_DATA_CONTROLLER_CONFIG_EXPORT")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source Controller Config Export test as SKIP_DATA_CONTROLLER_CONFIG_EXPORT is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAviatrixControllerConfigExportConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixControllerConfigExport(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "json"),
+					resource.TestCheckResourceAttrSet(resourceName, "sha256"),
+					resource.TestCheckResourceAttrPair(resourceName, "sha256", "data.aviatrix_controller_config_export.bar", "sha256"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAviatrixControllerConfigExportConfigBasic() string {
+	return `
+data "aviatrix_controller_config_export" "foo" {
+	sections = ["accounts", "gateways"]
+}
+data "aviatrix_controller_config_export" "bar" {
+	sections = ["gateways", "accounts"]
+}
+	`
+}
+
+func testAccDataSourceAviatrixControllerConfigExport(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
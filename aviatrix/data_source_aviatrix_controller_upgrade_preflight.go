@@ -0,0 +1,121 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixControllerUpgradePreflight() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixControllerUpgradePreflightRead,
+
+		Schema: map[string]*schema.Schema{
+			"target_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: "Version to check the upgrade to. Defaults to the latest version. " +
+					"Set to the resolved version after the checks.",
+			},
+			"min_free_disk_gb": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      goaviatrix.DefaultControllerUpgradeMinFreeDiskGB,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Minimum free disk space of the controller in GB.",
+			},
+			"max_backup_age_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(goaviatrix.DefaultControllerUpgradeMaxBackupAge / time.Hour),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum age of the last controller backup in hours.",
+			},
+			"current_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current version of the controller.",
+			},
+			"passed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether all the checks passed.",
+			},
+			"failed_check_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the checks that failed.",
+			},
+			"checks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Results of the checks.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Check name.",
+						},
+						"passed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the check passed.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Result of the check.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixControllerUpgradePreflightRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	preflight, err := client.RunControllerUpgradePreflight(ctx, goaviatrix.ControllerUpgradePreflightOptions{
+		TargetVersion: d.Get("target_version").(string),
+		MinFreeDiskGB: d.Get("min_free_disk_gb").(float64),
+		MaxBackupAge:  time.Duration(d.Get("max_backup_age_hours").(int)) * time.Hour,
+	})
+	if err != nil {
+		return diag.Errorf("failed to run controller upgrade pre-flight checks: %s", err)
+	}
+
+	failedCheckNames := []string{}
+	for _, check := range preflight.FailedChecks(nil) {
+		failedCheckNames = append(failedCheckNames, check.Name)
+	}
+	var checks []map[string]interface{}
+	for _, check := range preflight.Checks {
+		checks = append(checks, map[string]interface{}{
+			"name":    check.Name,
+			"passed":  check.Passed,
+			"message": check.Message,
+		})
+	}
+
+	d.Set("target_version", preflight.TargetVersion)
+	d.Set("current_version", preflight.CurrentVersion)
+	d.Set("passed", len(failedCheckNames) == 0)
+	if err := d.Set("failed_check_names", failedCheckNames); err != nil {
+		return diag.Errorf("failed to set failed_check_names: %s", err)
+	}
+	if err := d.Set("checks", checks); err != nil {
+		return diag.Errorf("failed to set checks: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("controller-upgrade-preflight-%s", preflight.TargetVersion))
+	return nil
+}
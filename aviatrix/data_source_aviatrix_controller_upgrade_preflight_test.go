@@ -0,0 +1,57 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixControllerUpgradePreflight_basic(t *testing.T) {
+	resourceName := "data.aviatrix_controller_upgrade_preflight.foo"
+
+	skipAcc := os.Getenv("SKIP_DATA_CONTROLLER_UPGRADE_PREFLIGHT")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source Controller Upgrade Preflight test as SKIP_DATA_CONTROLLER_UPGRADE_PREFLIGHT is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAviatrixControllerUpgradePreflightConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixControllerUpgradePreflight(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "current_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "target_version"),
+					resource.TestCheckResourceAttr(resourceName, "checks.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "checks.0.name", "upgrade_path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAviatrixControllerUpgradePreflightConfigBasic() string {
+	return `
+data "aviatrix_controller_upgrade_preflight" "foo" {
+	max_backup_age_hours = 168
+}
+	`
+}
+
+func testAccDataSourceAviatrixControllerUpgradePreflight(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,129 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixExpiringVPNUserCerts() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixExpiringVPNUserCertsRead,
+
+		Schema: map[string]*schema.Schema{
+			"days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "List VPN users whose certificate expires within this number of days.",
+			},
+			"user_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the VPN users whose certificate expires within the given number of days.",
+			},
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "VPN users whose certificate expires within the given number of days, soonest first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "VPN user name.",
+						},
+						"user_email": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "VPN user's email.",
+						},
+						"vpc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "VPC ID of the Aviatrix VPN gateway.",
+						},
+						"gw_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the ELB or the Aviatrix VPN gateway.",
+						},
+						"dns_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "FQDN of the DNS based VPN service.",
+						},
+						"cert_expiry": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Expiry of the certificate of the VPN user.",
+						},
+						"days_until_expiry": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of whole days until the certificate expires, negative if it already expired.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixExpiringVPNUserCertsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	vpnUsers, err := client.ListVPNUsers()
+	if err != nil {
+		return diag.Errorf("failed to list VPN users: %v", err)
+	}
+
+	days := d.Get("days").(int)
+	now := time.Now()
+	expiring, errs := goaviatrix.VPNUsersWithCertExpiringBefore(vpnUsers, now.AddDate(0, 0, days))
+	var diags diag.Diagnostics
+	for _, err := range errs {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Skipping VPN user with an invalid certificate expiry",
+			Detail:   err.Error(),
+		})
+	}
+
+	var userNames []string
+	var users []map[string]interface{}
+	for _, vu := range expiring {
+		expiry, _ := vu.CertExpiryTime()
+		user := map[string]interface{}{
+			"user_name":         vu.UserName,
+			"user_email":        vu.UserEmail,
+			"cert_expiry":       vu.CertExpiry,
+			"days_until_expiry": int(math.Floor(expiry.Sub(now).Hours() / 24)),
+		}
+		if vu.DnsEnabled {
+			user["dns_name"] = vu.DnsName
+		} else {
+			user["vpc_id"] = vu.VpcID
+			user["gw_name"] = vu.GwName
+		}
+		userNames = append(userNames, vu.UserName)
+		users = append(users, user)
+	}
+
+	if err := d.Set("user_names", userNames); err != nil {
+		return diag.Errorf("failed to set user_names: %v", err)
+	}
+	if err := d.Set("users", users); err != nil {
+		return diag.Errorf("failed to set users: %v", err)
+	}
+
+	d.SetId(fmt.Sprintf("expiring-vpn-user-certs-%d", days))
+	return diags
+}
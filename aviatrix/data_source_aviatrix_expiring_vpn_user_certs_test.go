@@ -0,0 +1,60 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixExpiringVPNUserCerts_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "data.aviatrix_expiring_vpn_user_certs.foo"
+
+	skipAcc := os.Getenv("SKIP_DATA_EXPIRING_VPN_USER_CERTS")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source Expiring VPN User Certs tests as SKIP_DATA_EXPIRING_VPN_USER_CERTS is set")
+	}
+	msg := ". Set SKIP_DATA_EXPIRING_VPN_USER_CERTS to yes to skip Data Source Expiring VPN User Certs tests"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, msg)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceExpiringVPNUserCertsConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixExpiringVPNUserCerts(resourceName),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_names.*", fmt.Sprintf("tfu-%s", rName)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceExpiringVPNUserCertsConfigBasic(rName string) string {
+	return testAccVPNUserConfigBasic(rName, "false", "", false) + `
+data "aviatrix_expiring_vpn_user_certs" "foo" {
+	days = 36500
+
+	depends_on = [aviatrix_vpn_user.test_vpn_user]
+}
+`
+}
+
+func testAccDataSourceAviatrixExpiringVPNUserCerts(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
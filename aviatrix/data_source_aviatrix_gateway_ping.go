@@ -0,0 +1,103 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixGatewayPing() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixGatewayPingRead,
+
+		Schema: map[string]*schema.Schema{
+			"gw_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the gateway to ping from.",
+			},
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "IP address or hostname to ping.",
+			},
+			"ping_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 100),
+				Description:  "Number of pings to send.",
+			},
+			"reachable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether at least one reply was received.",
+			},
+			"packets_sent": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of pings sent.",
+			},
+			"packets_received": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of replies received.",
+			},
+			"packet_loss": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Percentage of pings without reply.",
+			},
+			"min_latency": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Minimum round trip time in milliseconds.",
+			},
+			"avg_latency": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Average round trip time in milliseconds.",
+			},
+			"max_latency": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Maximum round trip time in milliseconds.",
+			},
+			"output": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Raw ping output.",
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixGatewayPingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+	target := d.Get("target").(string)
+
+	result, err := client.GatewayPing(ctx, gwName, target, d.Get("ping_count").(int))
+	if err != nil {
+		return diag.Errorf("failed to ping %s from gateway %s: %s", target, gwName, err)
+	}
+
+	d.Set("reachable", result.Reachable())
+	d.Set("packets_sent", result.PacketsSent)
+	d.Set("packets_received", result.PacketsReceived)
+	d.Set("packet_loss", result.PacketLoss)
+	d.Set("min_latency", result.MinLatency)
+	d.Set("avg_latency", result.AvgLatency)
+	d.Set("max_latency", result.MaxLatency)
+	d.Set("output", result.Output)
+
+	d.SetId(fmt.Sprintf("%s~%s", gwName, target))
+	return nil
+}
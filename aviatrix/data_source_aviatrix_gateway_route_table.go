@@ -0,0 +1,127 @@
+package aviatrix
+
+import (
+	"context"
+	"sort"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixGatewayRouteTable() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixGatewayRouteTableRead,
+
+		Schema: map[string]*schema.Schema{
+			"gw_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the gateway.",
+			},
+			"route_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return routes of the given type, for example 'bgp' or 'static'.",
+			},
+			"routes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of effective routes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gw_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the gateway the route is installed on.",
+						},
+						"destination": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Destination CIDR.",
+						},
+						"next_hop": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Next hop of the route.",
+						},
+						"interface": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Outgoing interface or tunnel.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the route.",
+						},
+						"metric": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Metric of the route.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the route.",
+						},
+					},
+				},
+			},
+			"cidrs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Sorted list of unique destination CIDRs.",
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixGatewayRouteTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+	routeType := d.Get("route_type").(string)
+
+	routeTable, err := client.GetGatewayRouteTable(ctx, gwName)
+	if err != nil {
+		return diag.Errorf("failed to get route table for gateway %s: %s", gwName, err)
+	}
+
+	var routes []map[string]interface{}
+	cidrSet := make(map[string]bool)
+	for _, route := range routeTable {
+		if routeType != "" && route.Type != routeType {
+			continue
+		}
+		routes = append(routes, map[string]interface{}{
+			"gw_name":     route.GwName,
+			"destination": route.Destination,
+			"next_hop":    route.NextHop,
+			"interface":   route.Interface,
+			"type":        route.Type,
+			"metric":      route.Metric,
+			"status":      route.Status,
+		})
+		cidrSet[route.Destination] = true
+	}
+
+	var cidrs []string
+	for cidr := range cidrSet {
+		cidrs = append(cidrs, cidr)
+	}
+	sort.Strings(cidrs)
+
+	if err := d.Set("routes", routes); err != nil {
+		return diag.Errorf("failed to set routes: %s", err)
+	}
+	if err := d.Set("cidrs", cidrs); err != nil {
+		return diag.Errorf("failed to set cidrs: %s", err)
+	}
+
+	d.SetId(gwName)
+	return nil
+}
@@ -0,0 +1,114 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixGatewayTracePath() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixGatewayTracePathRead,
+
+		Schema: map[string]*schema.Schema{
+			"gw_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the source gateway.",
+			},
+			"destination": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the destination gateway or destination IP address.",
+			},
+			"reachable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the destination is reachable from the source gateway.",
+			},
+			"reason": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Reason the destination is not reachable.",
+			},
+			"hops": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of hops from the source gateway to the destination.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the hop.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the hop, for example gateway or route table.",
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of the hop.",
+						},
+						"route": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Route matched at the hop.",
+						},
+						"next_hop": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Next hop of the matched route.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the hop.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixGatewayTracePathRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+	destination := d.Get("destination").(string)
+
+	result, err := client.GatewayTracePath(ctx, gwName, destination)
+	if err != nil {
+		return diag.Errorf("failed to trace path from gateway %s to %s: %s", gwName, destination, err)
+	}
+
+	var hops []map[string]interface{}
+	for _, hop := range result.Hops {
+		hops = append(hops, map[string]interface{}{
+			"name":     hop.Name,
+			"type":     hop.Type,
+			"ip":       hop.IP,
+			"route":    hop.Route,
+			"next_hop": hop.NextHop,
+			"status":   hop.Status,
+		})
+	}
+
+	d.Set("reachable", result.Reachable)
+	d.Set("reason", result.Reason)
+	if err := d.Set("hops", hops); err != nil {
+		return diag.Errorf("failed to set hops: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s~%s", gwName, destination))
+	return nil
+}
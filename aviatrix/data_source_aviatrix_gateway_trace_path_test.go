@@ -0,0 +1,77 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixGatewayTracePath_basic(t *testing.T) {
+	if os.Getenv("SKIP_DATA_GATEWAY_TRACE_PATH") == "yes" {
+		t.Skip("Skipping Data Source Gateway Trace Path test as SKIP_DATA_GATEWAY_TRACE_PATH is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "data.aviatrix_gateway_trace_path.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, ". Set SKIP_DATA_GATEWAY_TRACE_PATH to yes to skip Data Source Gateway Trace Path tests.")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGatewayTracePathBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixGatewayTracePath(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "reachable"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGatewayTracePathBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test" {
+	account_name       = "tfa-%[1]s"
+	cloud_type         = 1
+	aws_account_number = "%[2]s"
+	aws_iam            = false
+	aws_access_key     = "%[3]s"
+	aws_secret_key     = "%[4]s"
+}
+
+resource "aviatrix_gateway" "test_gw" {
+	cloud_type   = 1
+	account_name = aviatrix_account.test.account_name
+	gw_name      = "tfg-%[1]s"
+	vpc_id       = "%[5]s"
+	vpc_reg      = "%[6]s"
+	gw_size      = "t2.micro"
+	subnet       = "%[7]s"
+}
+
+data "aviatrix_gateway_trace_path" "test" {
+	gw_name     = aviatrix_gateway.test_gw.gw_name
+	destination = aviatrix_gateway.test_gw.private_ip
+}
+`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"))
+}
+
+func testAccDataSourceAviatrixGatewayTracePath(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
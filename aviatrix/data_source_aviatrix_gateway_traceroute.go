@@ -0,0 +1,116 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixGatewayTraceroute() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixGatewayTracerouteRead,
+
+		Schema: map[string]*schema.Schema{
+			"gw_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the gateway to run traceroute from.",
+			},
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "IP address or hostname to trace.",
+			},
+			"max_hops": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 64),
+				Description:  "Maximum number of hops.",
+			},
+			"reached": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the last hop is the target.",
+			},
+			"hops": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of hops to the target.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hop": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Hop number.",
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address that answered the probes.",
+						},
+						"hostname": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Hostname that answered the probes.",
+						},
+						"latencies": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeFloat},
+							Description: "Round trip time of each answered probe in milliseconds.",
+						},
+						"timed_out": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether no probe to this hop was answered.",
+						},
+					},
+				},
+			},
+			"output": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Raw traceroute output.",
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixGatewayTracerouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+	target := d.Get("target").(string)
+
+	result, err := client.GatewayTraceroute(ctx, gwName, target, d.Get("max_hops").(int))
+	if err != nil {
+		return diag.Errorf("failed to run traceroute to %s from gateway %s: %s", target, gwName, err)
+	}
+
+	var hops []map[string]interface{}
+	for _, hop := range result.Hops {
+		hops = append(hops, map[string]interface{}{
+			"hop":       hop.Hop,
+			"ip":        hop.IP,
+			"hostname":  hop.Hostname,
+			"latencies": hop.Latencies,
+			"timed_out": hop.TimedOut,
+		})
+	}
+
+	d.Set("reached", result.Reached)
+	d.Set("output", result.Output)
+	if err := d.Set("hops", hops); err != nil {
+		return diag.Errorf("failed to set hops: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s~%s", gwName, target))
+	return nil
+}
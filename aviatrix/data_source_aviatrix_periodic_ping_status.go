@@ -0,0 +1,91 @@
+package aviatrix
+
+import (
+	"fmt"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixPeriodicPingStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAviatrixPeriodicPingStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"gw_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of gateway.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether periodic ping is enabled on the gateway.",
+			},
+			"interval": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Interval between pings in seconds.",
+			},
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of IP Addresses being pinged.",
+			},
+			"ping_results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        periodicPingResultSchema(),
+				Description: "Last known ping result of each target.",
+			},
+			"all_reachable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether every target replied to the last ping.",
+			},
+			"unreachable_ip_addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of targets that did not reply to the last ping.",
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixPeriodicPingStatusRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+	status, err := client.GetPeriodicPingStatus(gwName)
+	if err != nil {
+		return fmt.Errorf("could not get periodic ping status for gateway %s: %v", gwName, err)
+	}
+
+	enabled := status.Status == "enabled"
+	var unreachable []string
+	for _, result := range status.PingResults {
+		if !result.IsReachable() {
+			unreachable = append(unreachable, result.IP)
+		}
+	}
+
+	d.Set("enabled", enabled)
+	d.Set("interval", status.Interval)
+	d.Set("all_reachable", enabled && len(status.PingResults) > 0 && len(unreachable) == 0)
+	if err := d.Set("ip_addresses", status.IPs); err != nil {
+		return fmt.Errorf("failed to set ip_addresses: %v", err)
+	}
+	if err := d.Set("ping_results", flattenPeriodicPingResults(status.PingResults)); err != nil {
+		return fmt.Errorf("failed to set ping_results: %v", err)
+	}
+	if err := d.Set("unreachable_ip_addresses", unreachable); err != nil {
+		return fmt.Errorf("failed to set unreachable_ip_addresses: %v", err)
+	}
+
+	d.SetId(gwName)
+	return nil
+}
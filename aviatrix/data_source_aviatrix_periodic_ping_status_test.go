@@ -0,0 +1,85 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixPeriodicPingStatus_basic(t *testing.T) {
+	if os.Getenv("SKIP_DATA_PERIODIC_PING_STATUS") == "yes" {
+		t.Skip("Skipping Data Source Periodic Ping Status test as SKIP_DATA_PERIODIC_PING_STATUS is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "data.aviatrix_periodic_ping_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, ". Set SKIP_DATA_PERIODIC_PING_STATUS to yes to skip Data Source Periodic Ping Status tests.")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePeriodicPingStatusBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixPeriodicPingStatus(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "interval", "5"),
+					resource.TestCheckResourceAttr(resourceName, "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "all_reachable"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePeriodicPingStatusBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test" {
+	account_name       = "tfa-%[1]s"
+	cloud_type         = 1
+	aws_account_number = "%[2]s"
+	aws_iam            = false
+	aws_access_key     = "%[3]s"
+	aws_secret_key     = "%[4]s"
+}
+
+resource "aviatrix_gateway" "test_gw" {
+	cloud_type   = 1
+	account_name = aviatrix_account.test.account_name
+	gw_name      = "tfg-%[1]s"
+	vpc_id       = "%[5]s"
+	vpc_reg      = "%[6]s"
+	gw_size      = "t2.micro"
+	subnet       = "%[7]s"
+}
+
+resource "aviatrix_periodic_ping" "test_periodic_ping" {
+	gw_name      = aviatrix_gateway.test_gw.gw_name
+	interval     = 5
+	ip_addresses = ["127.0.0.1", "127.0.0.2"]
+}
+
+data "aviatrix_periodic_ping_status" "test" {
+	gw_name = aviatrix_periodic_ping.test_periodic_ping.gw_name
+}
+`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"))
+}
+
+func testAccDataSourceAviatrixPeriodicPingStatus(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
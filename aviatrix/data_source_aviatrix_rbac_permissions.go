@@ -0,0 +1,101 @@
+package aviatrix
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixRbacPermissions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAviatrixRbacPermissionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"category": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the permissions of this category.",
+			},
+			"permission_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the RBAC permissions.",
+			},
+			"permissions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "RBAC permissions available on the controller.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Permission name, as used by 'aviatrix_rbac_group_permission_attachment'.",
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Display name of the permission.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the permission.",
+						},
+						"category": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Category of the permission.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixRbacPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*goaviatrix.Client)
+
+	category := d.Get("category").(string)
+
+	permissions, err := client.ListRbacPermissions()
+	if err != nil {
+		return fmt.Errorf("failed to list Aviatrix RBAC permissions: %s", err)
+	}
+	sort.Slice(permissions, func(i, j int) bool {
+		return permissions[i].Name < permissions[j].Name
+	})
+
+	permissionNames := []string{}
+	var result []map[string]interface{}
+	for _, permission := range permissions {
+		if category != "" && permission.Type != category {
+			continue
+		}
+		permissionNames = append(permissionNames, permission.Name)
+		result = append(result, map[string]interface{}{
+			"name":         permission.Name,
+			"display_name": permission.DisplayName,
+			"description":  permission.Description,
+			"category":     permission.Type,
+		})
+	}
+
+	if err := d.Set("permission_names", permissionNames); err != nil {
+		return fmt.Errorf("failed to set permission_names: %s", err)
+	}
+	if err := d.Set("permissions", result); err != nil {
+		return fmt.Errorf("failed to set permissions: %s", err)
+	}
+
+	if category != "" {
+		d.SetId("rbac-permissions-" + category)
+	} else {
+		d.SetId("rbac-permissions")
+	}
+	return nil
+}
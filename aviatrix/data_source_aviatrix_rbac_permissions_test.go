@@ -0,0 +1,53 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixRbacPermissions_basic(t *testing.T) {
+	resourceName := "data.aviatrix_rbac_permissions.foo"
+
+	skipAcc := os.Getenv("SKIP_DATA_RBAC_PERMISSIONS")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source RBAC Permissions test as SKIP_DATA_RBAC_PERMISSIONS is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAviatrixRbacPermissionsConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixRbacPermissions(resourceName),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission_names.*", "all_write"),
+					resource.TestCheckResourceAttrSet(resourceName, "permissions.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAviatrixRbacPermissionsConfigBasic() string {
+	return `
+data "aviatrix_rbac_permissions" "foo" {}
+	`
+}
+
+func testAccDataSourceAviatrixRbacPermissions(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no resource called %s", name)
+		}
+
+		return nil
+	}
+}
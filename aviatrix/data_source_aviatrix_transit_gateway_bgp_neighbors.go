@@ -0,0 +1,127 @@
+package aviatrix
+
+import (
+	"context"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixTransitGatewayBgpNeighbors() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixTransitGatewayBgpNeighborsRead,
+
+		Schema: map[string]*schema.Schema{
+			"gw_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the transit gateway.",
+			},
+			"connection_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return neighbors of the given connection.",
+			},
+			"neighbors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of BGP neighbors.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the connection.",
+						},
+						"gw_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the gateway the BGP session is established on.",
+						},
+						"neighbor_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of the BGP neighbor.",
+						},
+						"neighbor_as_number": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "AS number of the BGP neighbor.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the BGP session, for example \"Established\" or \"Active\".",
+						},
+						"uptime": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time since the BGP session entered its current state.",
+						},
+						"prefixes_received": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of prefixes received from the neighbor.",
+						},
+						"prefixes_advertised": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of prefixes advertised to the neighbor.",
+						},
+						"hold_time": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Negotiated BGP hold time in seconds.",
+						},
+						"keepalive_interval": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Negotiated BGP keepalive interval in seconds.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixTransitGatewayBgpNeighborsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+	connName := d.Get("connection_name").(string)
+
+	bgpNeighbors, err := client.GetTransitGatewayBgpNeighbors(ctx, gwName)
+	if err != nil {
+		return diag.Errorf("failed to get BGP neighbors for transit gateway %s: %s", gwName, err)
+	}
+
+	var neighbors []map[string]interface{}
+	for _, neighbor := range bgpNeighbors {
+		if connName != "" && neighbor.ConnectionName != connName {
+			continue
+		}
+		neighbors = append(neighbors, map[string]interface{}{
+			"connection_name":     neighbor.ConnectionName,
+			"gw_name":             neighbor.GwName,
+			"neighbor_ip":         neighbor.NeighborIP,
+			"neighbor_as_number":  neighbor.NeighborAsNumber,
+			"state":               neighbor.State,
+			"uptime":              neighbor.Uptime,
+			"prefixes_received":   neighbor.PrefixesReceived,
+			"prefixes_advertised": neighbor.PrefixesAdvertised,
+			"hold_time":           neighbor.HoldTime,
+			"keepalive_interval":  neighbor.KeepaliveInterval,
+		})
+	}
+
+	if err := d.Set("neighbors", neighbors); err != nil {
+		return diag.Errorf("failed to set neighbors: %s", err)
+	}
+
+	d.SetId(gwName)
+	return nil
+}
@@ -0,0 +1,89 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixTransitGatewayBgpNeighbors_basic(t *testing.T) {
+	if os.Getenv("SKIP_DATA_TRANSIT_GATEWAY_BGP_NEIGHBORS") == "yes" {
+		t.Skip("Skipping Data Source Transit Gateway BGP Neighbors test as SKIP_DATA_TRANSIT_GATEWAY_BGP_NEIGHBORS is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "data.aviatrix_transit_gateway_bgp_neighbors.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, ". Set SKIP_DATA_TRANSIT_GATEWAY_BGP_NEIGHBORS to yes to skip Data Source Transit Gateway BGP Neighbors tests.")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTransitGatewayBgpNeighborsBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixTransitGatewayBgpNeighbors(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "neighbors.0.connection_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "neighbors.0.neighbor_as_number", "65002"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTransitGatewayBgpNeighborsBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test" {
+	account_name       = "tfa-%[1]s"
+	cloud_type         = 1
+	aws_account_number = "%[2]s"
+	aws_iam            = false
+	aws_access_key     = "%[3]s"
+	aws_secret_key     = "%[4]s"
+}
+
+resource "aviatrix_transit_gateway" "test_gw" {
+	cloud_type      = 1
+	account_name    = aviatrix_account.test.account_name
+	gw_name         = "tfg-%[1]s"
+	vpc_id          = "%[5]s"
+	vpc_reg         = "%[6]s"
+	gw_size         = "t2.micro"
+	subnet          = "%[7]s"
+	local_as_number = "65001"
+}
+
+resource "aviatrix_transit_external_device_conn" "test" {
+	vpc_id            = aviatrix_transit_gateway.test_gw.vpc_id
+	connection_name   = "%[1]s"
+	gw_name           = aviatrix_transit_gateway.test_gw.gw_name
+	connection_type   = "bgp"
+	bgp_local_as_num  = "65001"
+	bgp_remote_as_num = "65002"
+	remote_gateway_ip = "172.12.13.14"
+}
+
+data "aviatrix_transit_gateway_bgp_neighbors" "test" {
+	gw_name         = aviatrix_transit_external_device_conn.test.gw_name
+	connection_name = aviatrix_transit_external_device_conn.test.connection_name
+}
+`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"))
+}
+
+func testAccDataSourceAviatrixTransitGatewayBgpNeighbors(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,211 @@
+package aviatrix
+
+import (
+	"context"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixTransitGatewayBgpRoutes() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixTransitGatewayBgpRoutesRead,
+
+		Schema: map[string]*schema.Schema{
+			"gw_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the transit gateway.",
+			},
+			"connection_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return routes of the given connection.",
+			},
+			"local_as_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Local AS number of the transit gateway.",
+			},
+			"bgp_lan_ip_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of BGP over LAN interface IPs of the transit gateway.",
+			},
+			"ha_bgp_lan_ip_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of BGP over LAN interface IPs of the HA transit gateway.",
+			},
+			"connections": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of BGP connections.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the connection.",
+						},
+						"gw_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the gateway the BGP session is established on.",
+						},
+						"neighbor_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of the BGP neighbor.",
+						},
+						"neighbor_as_number": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "AS number of the BGP neighbor.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the BGP session.",
+						},
+						"learned_routes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        bgpRouteSchema(),
+							Description: "List of routes learned from the neighbor.",
+						},
+						"advertised_routes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        bgpRouteSchema(),
+							Description: "List of routes advertised to the neighbor.",
+						},
+						"learned_cidrs": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Sorted list of unique prefixes learned from the neighbor.",
+						},
+						"advertised_cidrs": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Sorted list of unique prefixes advertised to the neighbor.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func bgpRouteSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"prefix": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Route prefix.",
+			},
+			"next_hop": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Next hop of the route.",
+			},
+			"as_path": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "AS path of the route.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the route, for example 'best' or 'valid'.",
+			},
+		},
+	}
+}
+
+func flattenBgpRoutes(routes []goaviatrix.BgpRoute) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, route := range routes {
+		result = append(result, map[string]interface{}{
+			"prefix":   route.Prefix,
+			"next_hop": route.NextHop,
+			"as_path":  route.AsPathList(),
+			"status":   route.Status,
+		})
+	}
+	return result
+}
+
+func dataSourceAviatrixTransitGatewayBgpRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+	connName := d.Get("connection_name").(string)
+	transitGateway := &goaviatrix.TransitVpc{
+		GwName: gwName,
+	}
+
+	advancedConfig, err := client.GetTransitGatewayAdvancedConfig(transitGateway)
+	if err != nil {
+		return diag.Errorf("failed to get advanced config for transit gateway %s: %s", gwName, err)
+	}
+
+	bgpLanIpInfo, err := client.GetBgpLanIPList(transitGateway)
+	if err != nil {
+		return diag.Errorf("failed to get BGP LAN ip list for transit gateway %s: %s", gwName, err)
+	}
+
+	bgpRoutes, err := client.GetTransitGatewayBgpRoutes(ctx, gwName)
+	if err != nil {
+		return diag.Errorf("failed to get BGP routes for transit gateway %s: %s", gwName, err)
+	}
+
+	var connections []map[string]interface{}
+	for _, conn := range bgpRoutes {
+		if connName != "" && conn.ConnectionName != connName {
+			continue
+		}
+		connections = append(connections, map[string]interface{}{
+			"connection_name":    conn.ConnectionName,
+			"gw_name":            conn.GwName,
+			"neighbor_ip":        conn.NeighborIP,
+			"neighbor_as_number": conn.NeighborAsNumber,
+			"status":             conn.Status,
+			"learned_routes":     flattenBgpRoutes(conn.LearnedRoutes),
+			"advertised_routes":  flattenBgpRoutes(conn.AdvertisedRoutes),
+			"learned_cidrs":      goaviatrix.UniqueBgpPrefixes(conn.LearnedRoutes),
+			"advertised_cidrs":   goaviatrix.UniqueBgpPrefixes(conn.AdvertisedRoutes),
+		})
+	}
+
+	if connName != "" && len(connections) == 0 {
+		return diag.Errorf("no BGP connection %q found on transit gateway %s", connName, gwName)
+	}
+
+	d.Set("local_as_number", advancedConfig.LocalASNumber)
+	if err := d.Set("bgp_lan_ip_list", bgpLanIpInfo.BgpLanIpList); err != nil {
+		return diag.Errorf("failed to set bgp_lan_ip_list: %s", err)
+	}
+	if err := d.Set("ha_bgp_lan_ip_list", bgpLanIpInfo.HaBgpLanIpList); err != nil {
+		return diag.Errorf("failed to set ha_bgp_lan_ip_list: %s", err)
+	}
+	if err := d.Set("connections", connections); err != nil {
+		return diag.Errorf("failed to set connections: %s", err)
+	}
+
+	if connName != "" {
+		d.SetId(gwName + "~" + connName)
+	} else {
+		d.SetId(gwName)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixTransitGatewayBgpRoutes_basic(t *testing.T) {
+	if os.Getenv("SKIP_DATA_TRANSIT_GATEWAY_BGP_ROUTES") == "yes" {
+		t.Skip("Skipping Data Source Transit Gateway BGP Routes test as SKIP_DATA_TRANSIT_GATEWAY_BGP_ROUTES is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "data.aviatrix_transit_gateway_bgp_routes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, ". Set SKIP_DATA_TRANSIT_GATEWAY_BGP_ROUTES to yes to skip Data Source Transit Gateway BGP Routes tests.")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTransitGatewayBgpRoutesBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixTransitGatewayBgpRoutes(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "local_as_number", "aviatrix_transit_gateway.test_gw", "local_as_number"),
+				),
+			},
+			{
+				Config:      testAccDataSourceTransitGatewayBgpRoutesUnknownConnection(rName),
+				ExpectError: regexp.MustCompile("no BGP connection \"tfc-unknown\" found"),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTransitGatewayBgpRoutesBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test" {
+	account_name       = "tfa-%[1]s"
+	cloud_type         = 1
+	aws_account_number = "%[2]s"
+	aws_iam            = false
+	aws_access_key     = "%[3]s"
+	aws_secret_key     = "%[4]s"
+}
+
+resource "aviatrix_transit_gateway" "test_gw" {
+	cloud_type      = 1
+	account_name    = aviatrix_account.test.account_name
+	gw_name         = "tfg-%[1]s"
+	vpc_id          = "%[5]s"
+	vpc_reg         = "%[6]s"
+	gw_size         = "t2.micro"
+	subnet          = "%[7]s"
+	local_as_number = "65001"
+}
+
+data "aviatrix_transit_gateway_bgp_routes" "test" {
+	gw_name = aviatrix_transit_gateway.test_gw.gw_name
+}
+`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"))
+}
+
+func testAccDataSourceTransitGatewayBgpRoutesUnknownConnection(rName string) string {
+	return testAccDataSourceTransitGatewayBgpRoutesBasic(rName) + `
+data "aviatrix_transit_gateway_bgp_routes" "unknown" {
+	gw_name         = aviatrix_transit_gateway.test_gw.gw_name
+	connection_name = "tfc-unknown"
+}
+`
+}
+
+func testAccDataSourceAviatrixTransitGatewayBgpRoutes(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
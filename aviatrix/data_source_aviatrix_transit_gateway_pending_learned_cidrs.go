@@ -0,0 +1,87 @@
+package aviatrix
+
+import (
+	"context"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixTransitGatewayPendingLearnedCidrs() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixTransitGatewayPendingLearnedCidrsRead,
+
+		Schema: map[string]*schema.Schema{
+			"gw_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the transit gateway.",
+			},
+			"connection_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the learned CIDRs of the given connection.",
+			},
+			"learned_cidrs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Learned CIDRs of the gateway, or of each of its connections if its learned CIDRs approval mode is 'connection'.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the connection. Empty if the learned CIDRs approval mode of the gateway is 'gateway'.",
+						},
+						"pending_cidrs": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Learned CIDRs waiting for approval.",
+						},
+						"approved_cidrs": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Approved learned CIDRs.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixTransitGatewayPendingLearnedCidrsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+	connName := d.Get("connection_name").(string)
+
+	pendingLearnedCidrs, err := client.GetPendingLearnedCidrs(ctx, gwName)
+	if err != nil {
+		return diag.Errorf("failed to get pending learned CIDRs for transit gateway %s: %s", gwName, err)
+	}
+
+	var learnedCidrs []map[string]interface{}
+	for _, v := range pendingLearnedCidrs {
+		if connName != "" && v.ConnectionName != connName {
+			continue
+		}
+		learnedCidrs = append(learnedCidrs, map[string]interface{}{
+			"connection_name": v.ConnectionName,
+			"pending_cidrs":   v.PendingCidrs,
+			"approved_cidrs":  v.ApprovedCidrs,
+		})
+	}
+
+	if err := d.Set("learned_cidrs", learnedCidrs); err != nil {
+		return diag.Errorf("failed to set learned_cidrs: %s", err)
+	}
+
+	d.SetId(gwName)
+	return nil
+}
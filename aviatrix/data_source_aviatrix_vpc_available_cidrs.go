@@ -0,0 +1,107 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixVpcAvailableCidrs() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixVpcAvailableCidrsRead,
+
+		Schema: map[string]*schema.Schema{
+			"supernet": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  "IPv4 CIDR to look for free blocks in.",
+			},
+			"prefix_length": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 32),
+				Description:  "Prefix length of the free blocks.",
+			},
+			"cidr_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of free blocks to return.",
+			},
+			"exclude_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+				Description: "Additional CIDRs to treat as used.",
+			},
+			"cidrs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of free blocks.",
+			},
+			"used_cidrs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Sorted list of used CIDRs that overlap the supernet.",
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixVpcAvailableCidrsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	supernet := d.Get("supernet").(string)
+	prefixLength := d.Get("prefix_length").(int)
+	count := d.Get("cidr_count").(int)
+
+	used, err := client.GetUsedVpcCidrs()
+	if err != nil {
+		return diag.Errorf("failed to get VPC CIDRs from the VPC tracker: %s", err)
+	}
+	used = append(used, getStringSet(d, "exclude_cidrs")...)
+	vpcCidrAllocations.Lock()
+	for _, cidr := range vpcCidrAllocations.cidrs {
+		used = append(used, cidr)
+	}
+	vpcCidrAllocations.Unlock()
+
+	cidrs, err := goaviatrix.NextAvailableCidrs(supernet, prefixLength, count, used)
+	if err != nil {
+		return diag.Errorf("failed to find free CIDRs: %s", err)
+	}
+
+	usedSet := make(map[string]bool)
+	for _, cidr := range used {
+		if overlap, err := goaviatrix.CidrsOverlap(supernet, cidr); err == nil && overlap {
+			usedSet[cidr] = true
+		}
+	}
+	var usedCidrs []string
+	for cidr := range usedSet {
+		usedCidrs = append(usedCidrs, cidr)
+	}
+	sort.Strings(usedCidrs)
+
+	if err := d.Set("cidrs", cidrs); err != nil {
+		return diag.Errorf("failed to set cidrs: %s", err)
+	}
+	if err := d.Set("used_cidrs", usedCidrs); err != nil {
+		return diag.Errorf("failed to set used_cidrs: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s~%d~%d", supernet, prefixLength, count))
+	return nil
+}
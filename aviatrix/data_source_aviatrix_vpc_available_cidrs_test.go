@@ -0,0 +1,56 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixVpcAvailableCidrs_basic(t *testing.T) {
+	if os.Getenv("SKIP_DATA_VPC_AVAILABLE_CIDRS") == "yes" {
+		t.Skip("Skipping Data Source VPC Available CIDRs test as SKIP_DATA_VPC_AVAILABLE_CIDRS is set")
+	}
+
+	resourceName := "data.aviatrix_vpc_available_cidrs.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVpcAvailableCidrsBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixVpcAvailableCidrs(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cidrs.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVpcAvailableCidrsBasic() string {
+	return `
+data "aviatrix_vpc_available_cidrs" "test" {
+	supernet      = "10.128.0.0/16"
+	prefix_length = 24
+	cidr_count    = 2
+	exclude_cidrs = ["10.128.0.0/24"]
+}
+`
+}
+
+func testAccDataSourceAviatrixVpcAvailableCidrs(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
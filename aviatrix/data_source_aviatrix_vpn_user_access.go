@@ -0,0 +1,162 @@
+package aviatrix
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixVPNUserAccess() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAviatrixVPNUserAccessRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "VPN user name.",
+			},
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "IP address or FQDN to check access to.",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+				Description:  "Port to check access to. Required unless protocol is 'icmp'.",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "tcp",
+				ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "icmp", "sctp", "rdp", "dccp"}, false),
+				Description:  "Protocol to check access with.",
+			},
+			"allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the user can reach the target.",
+			},
+			"profiles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Evaluation of each VPN profile attached to the user.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the VPN profile.",
+						},
+						"base_rule": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Base policy rule of the profile.",
+						},
+						"allowed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the profile allows the traffic.",
+						},
+						"matched_rule": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Index of the policy rule deciding the traffic, -1 if the base rule decides.",
+						},
+						"shadowed_rules": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Description of the policy rules that never take effect.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixVPNUserAccessRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*goaviatrix.Client)
+
+	userName := d.Get("user_name").(string)
+	target := d.Get("target").(string)
+	protocol := d.Get("protocol").(string)
+	port := d.Get("port").(int)
+	if _, ok := d.GetOk("port"); !ok && protocol != "icmp" {
+		return fmt.Errorf("'port' is required unless 'protocol' is 'icmp'")
+	}
+
+	vpnUsers, err := client.ListVPNUsers()
+	if err != nil {
+		return fmt.Errorf("failed to list VPN users: %v", err)
+	}
+	found := false
+	var profileNames []string
+	for _, vu := range vpnUsers {
+		if vu.UserName != userName {
+			continue
+		}
+		found = true
+		for _, name := range vu.Profiles {
+			if !goaviatrix.Contains(profileNames, name) {
+				profileNames = append(profileNames, name)
+			}
+		}
+	}
+	if !found {
+		return fmt.Errorf("VPN user %s not found", userName)
+	}
+	sort.Strings(profileNames)
+
+	// Profiles only grant access, a user without profiles is not restricted by any
+	allowed := len(profileNames) == 0
+	var profiles []map[string]interface{}
+	for _, name := range profileNames {
+		profile, err := client.GetProfileBasePolicy(&goaviatrix.Profile{Name: name})
+		if err != nil {
+			return fmt.Errorf("failed to get base policy of VPN profile %s: %v", name, err)
+		}
+		profile, err = client.GetProfile(profile)
+		if err != nil {
+			return fmt.Errorf("failed to get VPN profile %s: %v", name, err)
+		}
+
+		policy, err := goaviatrix.CompileProfilePolicy(profile.BaseRule, profile.Policy)
+		if err != nil {
+			return fmt.Errorf("failed to compile policy of VPN profile %s: %v", name, err)
+		}
+		profileAllowed, matchedRule, err := policy.Evaluate(protocol, target, port)
+		if err != nil {
+			return err
+		}
+		allowed = allowed || profileAllowed
+
+		var shadowedRules []string
+		for _, shadow := range policy.ShadowedRules() {
+			shadowedRules = append(shadowedRules, shadow.String())
+		}
+		profiles = append(profiles, map[string]interface{}{
+			"name":           name,
+			"base_rule":      profile.BaseRule,
+			"allowed":        profileAllowed,
+			"matched_rule":   matchedRule,
+			"shadowed_rules": shadowedRules,
+		})
+	}
+
+	d.Set("allowed", allowed)
+	if err := d.Set("profiles", profiles); err != nil {
+		return fmt.Errorf("failed to set profiles: %v", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s~%s~%s~%d", userName, protocol, target, port))
+	return nil
+}
@@ -0,0 +1,74 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixVPNUserAccess_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "data.aviatrix_vpn_user_access.foo"
+
+	skipAcc := os.Getenv("SKIP_DATA_VPN_USER_ACCESS")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source VPN User Access tests as SKIP_DATA_VPN_USER_ACCESS is set")
+	}
+	msg := ". Set SKIP_DATA_VPN_USER_ACCESS to yes to skip Data Source VPN User Access tests"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, msg)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVPNUserAccessConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixVPNUserAccess(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allowed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "profiles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "profiles.0.name", fmt.Sprintf("tfp-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "profiles.0.matched_rule", "0"),
+					resource.TestCheckResourceAttr("data.aviatrix_vpn_user_access.bar", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.aviatrix_vpn_user_access.bar", "profiles.0.matched_rule", "-1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVPNUserAccessConfigBasic(rName string) string {
+	return testAccVPNProfileConfigBasic(rName) + `
+data "aviatrix_vpn_user_access" "foo" {
+	user_name = aviatrix_vpn_user.test_vpn_user.user_name
+	target    = "10.0.0.0"
+	port      = 443
+
+	depends_on = [aviatrix_vpn_profile.test_vpn_profile]
+}
+data "aviatrix_vpn_user_access" "bar" {
+	user_name = aviatrix_vpn_user.test_vpn_user.user_name
+	target    = "10.0.0.1"
+	port      = 443
+
+	depends_on = [aviatrix_vpn_profile.test_vpn_profile]
+}
+`
+}
+
+func testAccDataSourceAviatrixVPNUserAccess(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
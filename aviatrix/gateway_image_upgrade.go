@@ -0,0 +1,52 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+)
+
+const defaultGatewayImageUpgradeHealthTimeout = 900
+
+// rollingGatewayImageUpgrade upgrades the image of a gateway and its HA gateway. Either may be nil if
+// only the other one is upgraded. The HA gateway is rebuilt first, and must be healthy with all its
+// tunnels up within healthTimeout before the primary gateway is rebuilt. The failover is implicit: no
+// traffic is switched by the provider, the controller moves the traffic of the primary gateway to the
+// healthy HA gateway once the primary gateway goes down for its rebuild, as for any gateway failure.
+// The async task log of the upgrades is logged as progress.
+func rollingGatewayImageUpgrade(ctx context.Context, client *goaviatrix.Client, gwType string, gw, hagw *goaviatrix.Gateway, healthTimeout time.Duration) error {
+	upgrade := func(gateway *goaviatrix.Gateway) error {
+		log.Printf("[INFO] Upgrading %s gateway %s to image_version=%s software_version=%s", gwType, gateway.GwName, gateway.ImageVersion, gateway.SoftwareVersion)
+		return client.UpgradeGatewayAsync(ctx, gateway, func(progress string) {
+			log.Printf("[INFO] Upgrade of %s gateway %s: %s", gwType, gateway.GwName, progress)
+		})
+	}
+	waitHealthy := func(gwName string) error {
+		return waitForGatewayHealth(ctx, client, gwName, true, healthTimeout)
+	}
+	return rollingGatewayUpgrade(gwType, gw, hagw, upgrade, waitHealthy)
+}
+
+// rollingGatewayUpgrade upgrades hagw, waits for it to be healthy, then upgrades gw. Nothing else is
+// upgraded once an upgrade or the health check fails.
+func rollingGatewayUpgrade(gwType string, gw, hagw *goaviatrix.Gateway, upgrade func(*goaviatrix.Gateway) error, waitHealthy func(string) error) error {
+	if hagw != nil {
+		if err := upgrade(hagw); err != nil {
+			return fmt.Errorf("could not upgrade HA %s gateway image_version=%s software_version=%s: %v", gwType, hagw.ImageVersion, hagw.SoftwareVersion, err)
+		}
+		if gw != nil {
+			if err := waitHealthy(hagw.GwName); err != nil {
+				return fmt.Errorf("HA %s gateway %s is unhealthy after its upgrade, not upgrading the primary gateway: %v", gwType, hagw.GwName, err)
+			}
+		}
+	}
+	if gw != nil {
+		if err := upgrade(gw); err != nil {
+			return fmt.Errorf("could not upgrade %s gateway image_version=%s software_version=%s: %v", gwType, gw.ImageVersion, gw.SoftwareVersion, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package aviatrix
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+)
+
+func TestRollingGatewayUpgrade(t *testing.T) {
+	gw := &goaviatrix.Gateway{GwName: "spoke"}
+	hagw := &goaviatrix.Gateway{GwName: "spoke-hagw"}
+
+	tt := []struct {
+		Name          string
+		Gw            *goaviatrix.Gateway
+		HaGw          *goaviatrix.Gateway
+		FailUpgrade   string
+		FailHealth    string
+		ExpectedSteps []string
+		ExpectedErr   bool
+	}{
+		{"primary and ha", gw, hagw, "", "", []string{"upgrade spoke-hagw", "health spoke-hagw", "upgrade spoke"}, false},
+		{"only primary", gw, nil, "", "", []string{"upgrade spoke"}, false},
+		{"only ha", nil, hagw, "", "", []string{"upgrade spoke-hagw"}, false},
+		{"ha upgrade fails", gw, hagw, "spoke-hagw", "", []string{"upgrade spoke-hagw"}, true},
+		{"ha unhealthy", gw, hagw, "", "spoke-hagw", []string{"upgrade spoke-hagw", "health spoke-hagw"}, true},
+		{"primary upgrade fails", gw, hagw, "spoke", "", []string{"upgrade spoke-hagw", "health spoke-hagw", "upgrade spoke"}, true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			var steps []string
+			upgrade := func(gateway *goaviatrix.Gateway) error {
+				steps = append(steps, "upgrade "+gateway.GwName)
+				if gateway.GwName == tc.FailUpgrade {
+					return errors.New("upgrade failed")
+				}
+				return nil
+			}
+			waitHealthy := func(gwName string) error {
+				steps = append(steps, "health "+gwName)
+				if gwName == tc.FailHealth {
+					return errors.New("tunnels down")
+				}
+				return nil
+			}
+
+			err := rollingGatewayUpgrade("spoke", tc.Gw, tc.HaGw, upgrade, waitHealthy)
+			if (err != nil) != tc.ExpectedErr {
+				t.Fatalf("test case %q expected error=%t, got %v", tc.Name, tc.ExpectedErr, err)
+			}
+			if !reflect.DeepEqual(steps, tc.ExpectedSteps) {
+				t.Fatalf("test case %q expected steps %v, got %v", tc.Name, tc.ExpectedSteps, steps)
+			}
+		})
+	}
+}
@@ -73,8 +73,10 @@ func Provider() *schema.Provider {
 		ResourcesMap: map[string]*schema.Resource{
 			"aviatrix_account":                                        resourceAviatrixAccount(),
 			"aviatrix_account_user":                                   resourceAviatrixAccountUser(),
+			"aviatrix_alicloud_account":                               resourceAviatrixAlicloudAccount(),
 			"aviatrix_app_domain":                                     resourceAviatrixAppDomain(),
 			"aviatrix_arm_peer":                                       resourceAviatrixARMPeer(),
+			"aviatrix_aws_account":                                    resourceAviatrixAwsAccount(),
 			"aviatrix_aws_peer":                                       resourceAviatrixAWSPeer(),
 			"aviatrix_aws_guard_duty":                                 resourceAviatrixAwsGuardDuty(),
 			"aviatrix_aws_tgw":                                        resourceAviatrixAWSTgw(),
@@ -90,12 +92,18 @@ func Provider() *schema.Provider {
 			"aviatrix_aws_tgw_transit_gateway_attachment":             resourceAviatrixAwsTgwTransitGatewayAttachment(),
 			"aviatrix_aws_tgw_vpc_attachment":                         resourceAviatrixAwsTgwVpcAttachment(),
 			"aviatrix_aws_tgw_vpn_conn":                               resourceAviatrixAwsTgwVpnConn(),
+			"aviatrix_azure_account":                                  resourceAviatrixAzureAccount(),
 			"aviatrix_azure_peer":                                     resourceAviatrixAzurePeer(),
 			"aviatrix_azure_spoke_native_peering":                     resourceAviatrixAzureSpokeNativePeering(),
 			"aviatrix_azure_vng_conn":                                 resourceAviatrixAzureVngConn(),
+			"aviatrix_bgp_connection_policy":                          resourceAviatrixBgpConnectionPolicy(),
+			"aviatrix_bgp_prefix_list":                                resourceAviatrixBgpPrefixList(),
+			"aviatrix_bgp_route_map":                                  resourceAviatrixBgpRouteMap(),
+			"aviatrix_bgp_route_map_attachment":                       resourceAviatrixBgpRouteMapAttachment(),
 			"aviatrix_cloudn_registration":                            resourceAviatrixCloudnRegistration(),
 			"aviatrix_cloudn_transit_gateway_attachment":              resourceAviatrixCloudnTransitGatewayAttachment(),
 			"aviatrix_cloudwatch_agent":                               resourceAviatrixCloudwatchAgent(),
+			"aviatrix_controller_backup":                              resourceAviatrixControllerBackup(),
 			"aviatrix_controller_bgp_max_as_limit_config":             resourceAviatrixControllerBgpMaxAsLimitConfig(),
 			"aviatrix_controller_cert_domain_config":                  resourceAviatrixControllerCertDomainConfig(),
 			"aviatrix_controller_config":                              resourceAviatrixControllerConfig(),
@@ -104,6 +112,7 @@ func Provider() *schema.Provider {
 			"aviatrix_controller_gateway_keepalive_config":            resourceAviatrixControllerGatewayKeepaliveConfig(),
 			"aviatrix_controller_private_mode_config":                 resourceAviatrixControllerPrivateModeConfig(),
 			"aviatrix_controller_private_oob":                         resourceAviatrixControllerPrivateOob(),
+			"aviatrix_controller_restore":                             resourceAviatrixControllerRestore(),
 			"aviatrix_controller_security_group_management_config":    resourceAviatrixControllerSecurityGroupManagementConfig(),
 			"aviatrix_copilot_association":                            resourceAviatrixCopilotAssociation(),
 			"aviatrix_copilot_security_group_management_config":       resourceAviatrixCopilotSecurityGroupManagementConfig(),
@@ -128,15 +137,21 @@ func Provider() *schema.Provider {
 			"aviatrix_gateway_certificate_config":                     resourceAviatrixGatewayCertificateConfig(),
 			"aviatrix_gateway_dnat":                                   resourceAviatrixGatewayDNat(),
 			"aviatrix_gateway_snat":                                   resourceAviatrixGatewaySNat(),
+			"aviatrix_gateway_upgrade_plan":                           resourceAviatrixGatewayUpgradePlan(),
+			"aviatrix_gcp_account":                                    resourceAviatrixGcpAccount(),
 			"aviatrix_geo_vpn":                                        resourceAviatrixGeoVPN(),
+			"aviatrix_learned_cidr_approval_policy":                   resourceAviatrixLearnedCidrApprovalPolicy(),
 			"aviatrix_microseg_policy_list":                           resourceAviatrixMicrosegPolicyList(),
 			"aviatrix_netflow_agent":                                  resourceAviatrixNetflowAgent(),
+			"aviatrix_oci_account":                                    resourceAviatrixOciAccount(),
 			"aviatrix_periodic_ping":                                  resourceAviatrixPeriodicPing(),
 			"aviatrix_private_mode_lb":                                resourceAviatrixPrivateModeLb(),
 			"aviatrix_private_mode_multicloud_endpoint":               resourceAviatrixPrivateModeMulticloudEndpoint(),
 			"aviatrix_proxy_config":                                   resourceAviatrixProxyConfig(),
 			"aviatrix_rbac_group":                                     resourceAviatrixRbacGroup(),
 			"aviatrix_rbac_group_access_account_attachment":           resourceAviatrixRbacGroupAccessAccountAttachment(),
+			"aviatrix_rbac_group_access_accounts":                     resourceAviatrixRbacGroupAccessAccounts(),
+			"aviatrix_rbac_group_membership":                          resourceAviatrixRbacGroupMembership(),
 			"aviatrix_rbac_group_permission_attachment":               resourceAviatrixRbacGroupPermissionAttachment(),
 			"aviatrix_rbac_group_user_attachment":                     resourceAviatrixRbacGroupUserAttachment(),
 			"aviatrix_remote_syslog":                                  resourceAviatrixRemoteSyslog(),
@@ -161,34 +176,56 @@ func Provider() *schema.Provider {
 			"aviatrix_trans_peer":                                     resourceAviatrixTransPeer(),
 			"aviatrix_transit_firenet_policy":                         resourceAviatrixTransitFireNetPolicy(),
 			"aviatrix_transit_gateway":                                resourceAviatrixTransitGateway(),
+			"aviatrix_transit_gateway_bgp_config":                     resourceAviatrixTransitGatewayBgpConfig(),
 			"aviatrix_transit_gateway_peering":                        resourceAviatrixTransitGatewayPeering(),
+			"aviatrix_transit_peering_mesh":                           resourceAviatrixTransitPeeringMesh(),
 			"aviatrix_transit_vpc":                                    resourceAviatrixTransitVpc(),
 			"aviatrix_tunnel":                                         resourceAviatrixTunnel(),
 			"aviatrix_vgw_conn":                                       resourceAviatrixVGWConn(),
 			"aviatrix_vpc":                                            resourceAviatrixVpc(),
+			"aviatrix_vpc_cidr_allocation":                            resourceAviatrixVpcCidrAllocation(),
 			"aviatrix_vpn_cert_download":                              resourceAviatrixVPNCertDownload(),
 			"aviatrix_vpn_profile":                                    resourceAviatrixProfile(),
 			"aviatrix_vpn_user":                                       resourceAviatrixVPNUser(),
+			"aviatrix_vpn_users":                                      resourceAviatrixVPNUsers(),
 			"aviatrix_vpn_user_accelerator":                           resourceAviatrixVPNUserAccelerator(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"aviatrix_account":                          dataSourceAviatrixAccount(),
-			"aviatrix_caller_identity":                  dataSourceAviatrixCallerIdentity(),
-			"aviatrix_device_interfaces":                dataSourceAviatrixDeviceInterfaces(),
-			"aviatrix_firenet":                          dataSourceAviatrixFireNet(),
-			"aviatrix_firenet_firewall_manager":         dataSourceAviatrixFireNetFirewallManager(),
-			"aviatrix_firenet_vendor_integration":       dataSourceAviatrixFireNetVendorIntegration(),
-			"aviatrix_gateway":                          dataSourceAviatrixGateway(),
-			"aviatrix_gateway_image":                    dataSourceAviatrixGatewayImage(),
-			"aviatrix_network_domains":                  dataSourceAviatrixNetworkDomains(),
-			"aviatrix_spoke_gateway":                    dataSourceAviatrixSpokeGateway(),
-			"aviatrix_spoke_gateway_inspection_subnets": dataSourceAviatrixSpokeGatewayInspectionSubnets(),
-			"aviatrix_transit_gateway":                  dataSourceAviatrixTransitGateway(),
-			"aviatrix_transit_gateways":                 dataSourceAviatrixTransitGateways(),
-			"aviatrix_vpc":                              dataSourceAviatrixVpc(),
-			"aviatrix_vpc_tracker":                      dataSourceAviatrixVpcTracker(),
-			"aviatrix_firewall":                         dataSourceAviatrixFirewall(),
-			"aviatrix_firewall_instance_images":         dataSourceAviatrixFirewallInstanceImages(),
+			"aviatrix_account":                               dataSourceAviatrixAccount(),
+			"aviatrix_account_audit":                         dataSourceAviatrixAccountAudit(),
+			"aviatrix_account_user_permissions":              dataSourceAviatrixAccountUserPermissions(),
+			"aviatrix_app_domain_resources":                  dataSourceAviatrixAppDomainResources(),
+			"aviatrix_caller_identity":                       dataSourceAviatrixCallerIdentity(),
+			"aviatrix_controller_backups":                    dataSourceAviatrixControllerBackups(),
+			"aviatrix_controller_config_export":              dataSourceAviatrixControllerConfigExport(),
+			"aviatrix_controller_upgrade_preflight":          dataSourceAviatrixControllerUpgradePreflight(),
+			"aviatrix_device_interfaces":                     dataSourceAviatrixDeviceInterfaces(),
+			"aviatrix_expiring_vpn_user_certs":               dataSourceAviatrixExpiringVPNUserCerts(),
+			"aviatrix_firenet":                               dataSourceAviatrixFireNet(),
+			"aviatrix_firenet_firewall_manager":              dataSourceAviatrixFireNetFirewallManager(),
+			"aviatrix_firenet_vendor_integration":            dataSourceAviatrixFireNetVendorIntegration(),
+			"aviatrix_gateway":                               dataSourceAviatrixGateway(),
+			"aviatrix_gateway_image":                         dataSourceAviatrixGatewayImage(),
+			"aviatrix_gateway_ping":                          dataSourceAviatrixGatewayPing(),
+			"aviatrix_gateway_route_table":                   dataSourceAviatrixGatewayRouteTable(),
+			"aviatrix_gateway_trace_path":                    dataSourceAviatrixGatewayTracePath(),
+			"aviatrix_gateway_traceroute":                    dataSourceAviatrixGatewayTraceroute(),
+			"aviatrix_network_domains":                       dataSourceAviatrixNetworkDomains(),
+			"aviatrix_periodic_ping_status":                  dataSourceAviatrixPeriodicPingStatus(),
+			"aviatrix_rbac_permissions":                      dataSourceAviatrixRbacPermissions(),
+			"aviatrix_spoke_gateway":                         dataSourceAviatrixSpokeGateway(),
+			"aviatrix_spoke_gateway_inspection_subnets":      dataSourceAviatrixSpokeGatewayInspectionSubnets(),
+			"aviatrix_transit_gateway":                       dataSourceAviatrixTransitGateway(),
+			"aviatrix_transit_gateway_bgp_neighbors":         dataSourceAviatrixTransitGatewayBgpNeighbors(),
+			"aviatrix_transit_gateway_bgp_routes":            dataSourceAviatrixTransitGatewayBgpRoutes(),
+			"aviatrix_transit_gateway_pending_learned_cidrs": dataSourceAviatrixTransitGatewayPendingLearnedCidrs(),
+			"aviatrix_transit_gateways":                      dataSourceAviatrixTransitGateways(),
+			"aviatrix_vpc":                                   dataSourceAviatrixVpc(),
+			"aviatrix_vpc_available_cidrs":                   dataSourceAviatrixVpcAvailableCidrs(),
+			"aviatrix_vpc_tracker":                           dataSourceAviatrixVpcTracker(),
+			"aviatrix_vpn_user_access":                       dataSourceAviatrixVPNUserAccess(),
+			"aviatrix_firewall":                              dataSourceAviatrixFirewall(),
+			"aviatrix_firewall_instance_images":              dataSourceAviatrixFirewallInstanceImages(),
 		},
 		ConfigureFunc: aviatrixConfigure,
 	}
@@ -12,6 +12,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// accountCredentialAttributes are the attributes of each cloud type holding the account credentials.
+var accountCredentialAttributes = map[int][]string{
+	goaviatrix.AWS:        {"aws_access_key", "aws_secret_key"},
+	goaviatrix.GCP:        {"gcloud_project_credentials_filepath"},
+	goaviatrix.Azure:      {"arm_application_id", "arm_application_key"},
+	goaviatrix.AzureGov:   {"azuregov_application_id", "azuregov_application_key"},
+	goaviatrix.AWSGov:     {"awsgov_access_key", "awsgov_secret_key"},
+	goaviatrix.AWSChina:   {"awschina_access_key", "awschina_secret_key"},
+	goaviatrix.AzureChina: {"azurechina_application_id", "azurechina_application_key"},
+	goaviatrix.AliCloud:   {"alicloud_access_key", "alicloud_secret_key"},
+	goaviatrix.AWSTS:      {"awsts_cap_cert", "awsts_cap_cert_key", "awsts_ca_chain_cert"},
+	goaviatrix.AWSS:       {"awss_cap_cert", "awss_cap_cert_key", "awss_ca_chain_cert"},
+}
+
 func resourceAviatrixAccount() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAviatrixAccountCreate,
@@ -206,6 +220,13 @@ func resourceAviatrixAccount() *schema.Resource {
 				Default:     false,
 				Description: "Enable account audit.",
 			},
+			"verify_credential_rotation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "Audit the account right after its credentials change and restore the previous " +
+					"credentials if the audit fails.",
+			},
 			"awschina_account_number": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -780,6 +801,7 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 		id := d.Id()
 		log.Printf("[DEBUG] Looks like an import, no account name received. Import Id is %s", id)
 		d.Set("account_name", id)
+		d.Set("verify_credential_rotation", true)
 		d.SetId(id)
 	}
 
@@ -897,78 +919,11 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 
-	account := &goaviatrix.Account{
-		AccountName:                           d.Get("account_name").(string),
-		CloudType:                             d.Get("cloud_type").(int),
-		AwsAccountNumber:                      d.Get("aws_account_number").(string),
-		AwsRoleApp:                            d.Get("aws_role_app").(string),
-		AwsRoleEc2:                            d.Get("aws_role_ec2").(string),
-		AwsGatewayRoleApp:                     d.Get("aws_gateway_role_app").(string),
-		AwsGatewayRoleEc2:                     d.Get("aws_gateway_role_ec2").(string),
-		AwsAccessKey:                          d.Get("aws_access_key").(string),
-		AwsSecretKey:                          d.Get("aws_secret_key").(string),
-		AwsgovAccountNumber:                   d.Get("awsgov_account_number").(string),
-		AwsgovRoleApp:                         d.Get("awsgov_role_app").(string),
-		AwsgovRoleEc2:                         d.Get("awsgov_role_ec2").(string),
-		AwsgovAccessKey:                       d.Get("awsgov_access_key").(string),
-		AwsgovSecretKey:                       d.Get("awsgov_secret_key").(string),
-		GcloudProjectName:                     d.Get("gcloud_project_id").(string),
-		GcloudProjectCredentialsFilepathLocal: d.Get("gcloud_project_credentials_filepath").(string),
-		ArmSubscriptionId:                     d.Get("arm_subscription_id").(string),
-		ArmApplicationEndpoint:                d.Get("arm_directory_id").(string),
-		ArmApplicationClientId:                d.Get("arm_application_id").(string),
-		ArmApplicationClientSecret:            d.Get("arm_application_key").(string),
-		AzuregovSubscriptionId:                d.Get("azuregov_subscription_id").(string),
-		AzuregovApplicationEndpoint:           d.Get("azuregov_directory_id").(string),
-		AzuregovApplicationClientId:           d.Get("azuregov_application_id").(string),
-		AzuregovApplicationClientSecret:       d.Get("azuregov_application_key").(string),
-		OciTenancyID:                          d.Get("oci_tenancy_id").(string),
-		OciUserID:                             d.Get("oci_user_id").(string),
-		OciCompartmentID:                      d.Get("oci_compartment_id").(string),
-		OciApiPrivateKeyFilePath:              d.Get("oci_api_private_key_filepath").(string),
-		AlicloudAccountId:                     d.Get("alicloud_account_id").(string),
-		AlicloudAccessKey:                     d.Get("alicloud_access_key").(string),
-		AlicloudSecretKey:                     d.Get("alicloud_secret_key").(string),
-		AwsChinaAccountNumber:                 d.Get("awschina_account_number").(string),
-		AwsChinaRoleApp:                       d.Get("awschina_role_app").(string),
-		AwsChinaRoleEc2:                       d.Get("awschina_role_ec2").(string),
-		AwsChinaAccessKey:                     d.Get("awschina_access_key").(string),
-		AwsChinaSecretKey:                     d.Get("awschina_secret_key").(string),
-		AzureChinaSubscriptionId:              d.Get("azurechina_subscription_id").(string),
-		AzureChinaApplicationEndpoint:         d.Get("azurechina_directory_id").(string),
-		AzureChinaApplicationClientId:         d.Get("azurechina_application_id").(string),
-		AzureChinaApplicationClientSecret:     d.Get("azurechina_application_key").(string),
-		AwsTsAccountNumber:                    d.Get("awsts_account_number").(string),
-		AwsTsCapUrl:                           d.Get("awsts_cap_url").(string),
-		AwsTsCapAgency:                        d.Get("awsts_cap_agency").(string),
-		AwsTsCapMission:                       d.Get("awsts_cap_mission").(string),
-		AwsTsCapRoleName:                      d.Get("awsts_cap_role_name").(string),
-		AwsTsCapCert:                          d.Get("awsts_cap_cert").(string),
-		AwsTsCapCertKey:                       d.Get("awsts_cap_cert_key").(string),
-		AwsTsCaChainCert:                      d.Get("awsts_ca_chain_cert").(string),
-		AwsTsCapCertPath:                      d.Get("awsts_cap_cert_path").(string),
-		AwsTsCapCertKeyPath:                   d.Get("awsts_cap_cert_key_path").(string),
-		AwsCaCertPath:                         d.Get("aws_ca_cert_path").(string),
-		AwsSAccountNumber:                     d.Get("awss_account_number").(string),
-		AwsSCapUrl:                            d.Get("awss_cap_url").(string),
-		AwsSCapAgency:                         d.Get("awss_cap_agency").(string),
-		AwsSCapAccountName:                    d.Get("awss_cap_account_name").(string),
-		AwsSCapRoleName:                       d.Get("awss_cap_role_name").(string),
-		AwsSCapCert:                           d.Get("awss_cap_cert").(string),
-		AwsSCapCertKey:                        d.Get("awss_cap_cert_key").(string),
-		AwsSCaChainCert:                       d.Get("awss_ca_chain_cert").(string),
-		AwsSCapCertPath:                       d.Get("awss_cap_cert_path").(string),
-		AwsSCapCertKeyPath:                    d.Get("awss_cap_cert_key_path").(string),
-	}
+	account := accountFromResourceData(d.Get)
 
 	awsIam := d.Get("aws_iam").(bool)
-	account.AwsIam = strconv.FormatBool(awsIam)
-
 	awsGovIam := d.Get("awsgov_iam").(bool)
-	account.AwsgovIam = strconv.FormatBool(awsGovIam)
-
 	awsChinaIam := d.Get("awschina_iam").(bool)
-	account.AwsChinaIam = strconv.FormatBool(awsChinaIam)
 
 	log.Printf("[INFO] Updating Aviatrix account: %#v", account)
 
@@ -978,6 +933,18 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("update cloud_type is not allowed")
 	}
 
+	// Changed credentials are audited right away and, unless they were uploaded from files, restored if
+	// the audit fails
+	updateAccount := func(update func(*goaviatrix.Account) error) error {
+		var previous func() *goaviatrix.Account
+		if !goaviatrix.IsCloudType(account.CloudType, goaviatrix.GCP|goaviatrix.AWSTS|goaviatrix.AWSS) {
+			previous = func() *goaviatrix.Account {
+				return accountFromResourceData(oldResourceDataGetter(d))
+			}
+		}
+		return updateAccountCredentials(ctx, d, client, account, accountCredentialAttributes[account.CloudType], previous, update)
+	}
+
 	if d.HasChanges("aws_gateway_role_app", "aws_gateway_role_ec2") {
 		_, gatewayRoleAppOk := d.GetOk("aws_gateway_role_app")
 		_, gatewayRoleEc2Ok := d.GetOk("aws_gateway_role_ec2")
@@ -1041,21 +1008,21 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		if d.HasChanges("aws_account_number", "aws_access_key", "aws_secret_key", "aws_iam", "aws_role_app", "aws_role_ec2", "aws_gateway_role_app", "aws_gateway_role_ec2") {
-			err := client.UpdateAccount(account)
+			err := updateAccount(client.UpdateAccount)
 			if err != nil {
 				return diag.Errorf("failed to update Aviatrix Account: %s", err)
 			}
 		}
 	} else if account.CloudType == goaviatrix.GCP {
 		if d.HasChange("gcloud_project_id") || d.HasChange("gcloud_project_credentials_filepath") {
-			err := client.UpdateGCPAccount(account)
+			err := updateAccount(client.UpdateGCPAccount)
 			if err != nil {
 				return diag.Errorf("failed to update Aviatrix Account: %s", err)
 			}
 		}
 	} else if account.CloudType == goaviatrix.Azure {
 		if d.HasChange("arm_subscription_id") || d.HasChange("arm_directory_id") || d.HasChange("arm_application_id") || d.HasChange("arm_application_key") {
-			err := client.UpdateAccount(account)
+			err := updateAccount(client.UpdateAccount)
 			if err != nil {
 				return diag.Errorf("failed to update Aviatrix Account: %s", err)
 			}
@@ -1072,21 +1039,21 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		if d.HasChanges("awsgov_account_number", "awsgov_access_key", "awsgov_secret_key", "awsgov_iam", "awsgov_role_app", "awsgov_role_ec2", "aws_gateway_role_app", "aws_gateway_role_ec2") {
-			err := client.UpdateAccount(account)
+			err := updateAccount(client.UpdateAccount)
 			if err != nil {
 				return diag.Errorf("failed to update Aviatrix Account: %s", err)
 			}
 		}
 	} else if account.CloudType == goaviatrix.AzureGov {
 		if d.HasChanges("azuregov_subscription_id", "azuregov_directory_id", "azuregov_application_id", "azuregov_application_key") {
-			err := client.UpdateAccount(account)
+			err := updateAccount(client.UpdateAccount)
 			if err != nil {
 				return diag.Errorf("failed to update Azure GOV Aviatrix Account: %v", err)
 			}
 		}
 	} else if goaviatrix.IsCloudType(account.CloudType, goaviatrix.AWSChina) {
 		if d.HasChanges("awschina_iam", "awschina_role_app", "awschina_role_ec2", "awschina_access_key", "awschina_secret_key", "aws_gateway_role_app", "aws_gateway_role_ec2") {
-			err := client.UpdateAccount(account)
+			err := updateAccount(client.UpdateAccount)
 			if err != nil {
 				return diag.Errorf("failed to update AWSChina Aviatrix Account: %v", err)
 			}
@@ -1099,14 +1066,14 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		if d.HasChanges("azurechina_subscription_id", "azurechina_directory_id", "azurechina_application_id", "azurechina_application_key") {
-			err := client.UpdateAccount(account)
+			err := updateAccount(client.UpdateAccount)
 			if err != nil {
 				return diag.Errorf("failed to update AzureChina Aviatrix Account: %v", err)
 			}
 		}
 	} else if account.CloudType == goaviatrix.AliCloud {
 		if d.HasChange("alicloud_account_id") || d.HasChange("alicloud_access_key") || d.HasChange("alicloud_secret_key") {
-			err := client.UpdateAccount(account)
+			err := updateAccount(client.UpdateAccount)
 			if err != nil {
 				return diag.Errorf("failed to update Aviatrix Account: %s", err)
 			}
@@ -1120,7 +1087,9 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 		hasFileChanges := fileChanges["awsts_cap_cert"] || fileChanges["awsts_cap_cert_key"] || fileChanges["awsts_ca_chain_cert"]
 
 		if d.HasChanges("awsts_account_number", "awsts_cap_url", "awsts_cap_agency", "awsts_cap_mission", "awsts_cap_role_name") || hasFileChanges {
-			err := client.UpdateAWSTSAccount(account, fileChanges)
+			err := updateAccount(func(account *goaviatrix.Account) error {
+				return client.UpdateAWSTSAccount(account, fileChanges)
+			})
 			if err != nil {
 				return diag.Errorf("failed to update AWS Secret Aviatrix Account: %v", err)
 			}
@@ -1134,7 +1103,9 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 		hasFileChanges := fileChanges["awss_cap_cert"] || fileChanges["awss_cap_cert_key"] || fileChanges["awss_ca_chain_cert"]
 
 		if d.HasChanges("awss_account_number", "awss_cap_url", "awss_cap_agency", "awss_cap_account_name", "awss_cap_role_name") || hasFileChanges {
-			err := client.UpdateAWSSAccount(account, fileChanges)
+			err := updateAccount(func(account *goaviatrix.Account) error {
+				return client.UpdateAWSSAccount(account, fileChanges)
+			})
 			if err != nil {
 				return diag.Errorf("failed to update AWS Top Secret Aviatrix Account: %v", err)
 			}
@@ -1145,6 +1116,79 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 	return resourceAviatrixAccountRead(ctx, d, meta)
 }
 
+// accountFromResourceData builds the account to update from the given getter, which returns either the
+// new or the previous value of an attribute.
+func accountFromResourceData(get func(key string) interface{}) *goaviatrix.Account {
+	account := &goaviatrix.Account{
+		AccountName:                           get("account_name").(string),
+		CloudType:                             get("cloud_type").(int),
+		AwsAccountNumber:                      get("aws_account_number").(string),
+		AwsRoleApp:                            get("aws_role_app").(string),
+		AwsRoleEc2:                            get("aws_role_ec2").(string),
+		AwsGatewayRoleApp:                     get("aws_gateway_role_app").(string),
+		AwsGatewayRoleEc2:                     get("aws_gateway_role_ec2").(string),
+		AwsAccessKey:                          get("aws_access_key").(string),
+		AwsSecretKey:                          get("aws_secret_key").(string),
+		AwsgovAccountNumber:                   get("awsgov_account_number").(string),
+		AwsgovRoleApp:                         get("awsgov_role_app").(string),
+		AwsgovRoleEc2:                         get("awsgov_role_ec2").(string),
+		AwsgovAccessKey:                       get("awsgov_access_key").(string),
+		AwsgovSecretKey:                       get("awsgov_secret_key").(string),
+		GcloudProjectName:                     get("gcloud_project_id").(string),
+		GcloudProjectCredentialsFilepathLocal: get("gcloud_project_credentials_filepath").(string),
+		ArmSubscriptionId:                     get("arm_subscription_id").(string),
+		ArmApplicationEndpoint:                get("arm_directory_id").(string),
+		ArmApplicationClientId:                get("arm_application_id").(string),
+		ArmApplicationClientSecret:            get("arm_application_key").(string),
+		AzuregovSubscriptionId:                get("azuregov_subscription_id").(string),
+		AzuregovApplicationEndpoint:           get("azuregov_directory_id").(string),
+		AzuregovApplicationClientId:           get("azuregov_application_id").(string),
+		AzuregovApplicationClientSecret:       get("azuregov_application_key").(string),
+		OciTenancyID:                          get("oci_tenancy_id").(string),
+		OciUserID:                             get("oci_user_id").(string),
+		OciCompartmentID:                      get("oci_compartment_id").(string),
+		OciApiPrivateKeyFilePath:              get("oci_api_private_key_filepath").(string),
+		AlicloudAccountId:                     get("alicloud_account_id").(string),
+		AlicloudAccessKey:                     get("alicloud_access_key").(string),
+		AlicloudSecretKey:                     get("alicloud_secret_key").(string),
+		AwsChinaAccountNumber:                 get("awschina_account_number").(string),
+		AwsChinaRoleApp:                       get("awschina_role_app").(string),
+		AwsChinaRoleEc2:                       get("awschina_role_ec2").(string),
+		AwsChinaAccessKey:                     get("awschina_access_key").(string),
+		AwsChinaSecretKey:                     get("awschina_secret_key").(string),
+		AzureChinaSubscriptionId:              get("azurechina_subscription_id").(string),
+		AzureChinaApplicationEndpoint:         get("azurechina_directory_id").(string),
+		AzureChinaApplicationClientId:         get("azurechina_application_id").(string),
+		AzureChinaApplicationClientSecret:     get("azurechina_application_key").(string),
+		AwsTsAccountNumber:                    get("awsts_account_number").(string),
+		AwsTsCapUrl:                           get("awsts_cap_url").(string),
+		AwsTsCapAgency:                        get("awsts_cap_agency").(string),
+		AwsTsCapMission:                       get("awsts_cap_mission").(string),
+		AwsTsCapRoleName:                      get("awsts_cap_role_name").(string),
+		AwsTsCapCert:                          get("awsts_cap_cert").(string),
+		AwsTsCapCertKey:                       get("awsts_cap_cert_key").(string),
+		AwsTsCaChainCert:                      get("awsts_ca_chain_cert").(string),
+		AwsTsCapCertPath:                      get("awsts_cap_cert_path").(string),
+		AwsTsCapCertKeyPath:                   get("awsts_cap_cert_key_path").(string),
+		AwsCaCertPath:                         get("aws_ca_cert_path").(string),
+		AwsSAccountNumber:                     get("awss_account_number").(string),
+		AwsSCapUrl:                            get("awss_cap_url").(string),
+		AwsSCapAgency:                         get("awss_cap_agency").(string),
+		AwsSCapAccountName:                    get("awss_cap_account_name").(string),
+		AwsSCapRoleName:                       get("awss_cap_role_name").(string),
+		AwsSCapCert:                           get("awss_cap_cert").(string),
+		AwsSCapCertKey:                        get("awss_cap_cert_key").(string),
+		AwsSCaChainCert:                       get("awss_ca_chain_cert").(string),
+		AwsSCapCertPath:                       get("awss_cap_cert_path").(string),
+		AwsSCapCertKeyPath:                    get("awss_cap_cert_key_path").(string),
+	}
+
+	account.AwsIam = strconv.FormatBool(get("aws_iam").(bool))
+	account.AwsgovIam = strconv.FormatBool(get("awsgov_iam").(bool))
+	account.AwsChinaIam = strconv.FormatBool(get("awschina_iam").(bool))
+	return account
+}
+
 //for now, deleting gcp account will not delete the credential file
 func resourceAviatrixAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
@@ -1,6 +1,7 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -772,3 +773,46 @@ func testAccCheckAccountDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccCheckCloudAccountDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			foundAccount := &goaviatrix.Account{
+				AccountName: rs.Primary.Attributes["account_name"],
+			}
+
+			_, err := client.GetAccount(foundAccount)
+			if err != goaviatrix.ErrNotFound {
+				return fmt.Errorf("account still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckImportedCloudAccountPlanEmpty checks that the plan of the configuration attributes config after the
+// import of a cloud account resource of type resourceType neither updates nor replaces the account.
+func testAccCheckImportedCloudAccountPlanEmpty(resourceType string, config map[string]interface{}) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) != 1 {
+			return fmt.Errorf("expected 1 imported %s, got %d", resourceType, len(states))
+		}
+
+		diff, err := testAccProvider.ResourcesMap[resourceType].Diff(context.Background(), states[0],
+			terraform.NewResourceConfigRaw(config), testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		if diff != nil && !diff.Empty() {
+			return fmt.Errorf("expected an empty plan after the import of %s, got: %#v", resourceType, diff.Attributes)
+		}
+		return nil
+	}
+}
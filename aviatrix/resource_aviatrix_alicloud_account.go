@@ -0,0 +1,53 @@
+package aviatrix
+
+import (
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixAlicloudAccount() *schema.Resource {
+	return resourceAviatrixCloudAccount(cloudAccount{
+		name:                  "Alibaba Cloud",
+		cloudTypes:            goaviatrix.AliCloud,
+		credentialAttributes:  []string{"access_key", "secret_key"},
+		restorableCredentials: true,
+		expand:                expandAlicloudAccount,
+		flatten:               flattenAlicloudAccount,
+		schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Alibaba Cloud Account ID.",
+			},
+			"access_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Alibaba Cloud Access Key.",
+			},
+			"secret_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Alibaba Cloud Secret Key.",
+			},
+		},
+	})
+}
+
+func expandAlicloudAccount(get func(key string) interface{}, account *goaviatrix.Account) error {
+	account.CloudType = goaviatrix.AliCloud
+	account.AlicloudAccountId = get("account_id").(string)
+	account.AlicloudAccessKey = get("access_key").(string)
+	account.AlicloudSecretKey = get("secret_key").(string)
+	return nil
+}
+
+func flattenAlicloudAccount(d *schema.ResourceData, account *goaviatrix.Account) {
+	// The controller returns the Alibaba Cloud account ID as the account number
+	d.Set("account_id", account.AwsAccountNumber)
+}
@@ -35,53 +35,7 @@ func resourceAviatrixAppDomain() *schema.Resource {
 						"match_expressions": {
 							Type:     schema.TypeList,
 							Required: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"cidr": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.Any(validation.IsCIDR, validation.IsIPAddress),
-										Description:  "CIDR block or IP Address this expression matches.",
-									},
-									"type": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringInSlice([]string{"vm", "vpc", "subnet"}, false),
-										Description:  "Type of resource this expression matches.",
-									},
-									"res_id": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Resource ID this expression matches.",
-									},
-									"account_id": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Account ID this expression matches.",
-									},
-									"account_name": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Account name this expression matches.",
-									},
-									"region": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Region this expression matches.",
-									},
-									"zone": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Zone this expression matches.",
-									},
-									"tags": {
-										Type:        schema.TypeMap,
-										Optional:    true,
-										Elem:        &schema.Schema{Type: schema.TypeString},
-										Description: "Map of tags this expression matches.",
-									},
-								},
-							},
+							Elem:     appDomainMatchExpressionSchema(),
 						},
 					},
 				},
@@ -96,12 +50,74 @@ func resourceAviatrixAppDomain() *schema.Resource {
 	}
 }
 
+func appDomainMatchExpressionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.Any(validation.IsCIDR, validation.IsIPAddress),
+				Description:  "CIDR block or IP Address this expression matches.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"vm", "vpc", "subnet"}, false),
+				Description:  "Type of resource this expression matches.",
+			},
+			"res_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Resource ID this expression matches.",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Account ID this expression matches.",
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Account name this expression matches.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Region this expression matches.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Zone this expression matches.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Map of tags this expression matches.",
+			},
+		},
+	}
+}
+
 func marshalAppDomainInput(d *schema.ResourceData) (*goaviatrix.AppDomain, error) {
+	selector, err := marshalAppDomainSelector(d.Get("selector.0.match_expressions").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
 	appDomain := &goaviatrix.AppDomain{
-		Name: d.Get("name").(string),
+		Name:     d.Get("name").(string),
+		Selector: *selector,
 	}
 
-	for _, selectorInterface := range d.Get("selector.0.match_expressions").([]interface{}) {
+	return appDomain, nil
+}
+
+func marshalAppDomainSelector(matchExpressions []interface{}) (*goaviatrix.AppDomainSelector, error) {
+	selector := &goaviatrix.AppDomainSelector{}
+
+	for _, selectorInterface := range matchExpressions {
 		if selectorInterface == nil {
 			return nil, fmt.Errorf("match expressions block cannot be empty")
 		}
@@ -140,10 +156,10 @@ func marshalAppDomainInput(d *schema.ResourceData) (*goaviatrix.AppDomain, error
 			}
 		}
 
-		appDomain.Selector.Expressions = append(appDomain.Selector.Expressions, filter)
+		selector.Expressions = append(selector.Expressions, filter)
 	}
 
-	return appDomain, nil
+	return selector, nil
 }
 
 func resourceAviatrixAppDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
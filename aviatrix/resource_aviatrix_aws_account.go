@@ -0,0 +1,177 @@
+package aviatrix
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// awsPartitionCloudTypes maps the AWS partitions to the cloud type of their accounts.
+var awsPartitionCloudTypes = map[string]int{
+	"aws":        goaviatrix.AWS,
+	"aws-us-gov": goaviatrix.AWSGov,
+	"aws-cn":     goaviatrix.AWSChina,
+}
+
+func resourceAviatrixAwsAccount() *schema.Resource {
+	return resourceAviatrixCloudAccount(cloudAccount{
+		name:                  "AWS",
+		cloudTypes:            goaviatrix.AWS | goaviatrix.AWSGov | goaviatrix.AWSChina,
+		credentialAttributes:  []string{"access_key", "secret_key"},
+		restorableCredentials: true,
+		expand:                expandAwsAccount,
+		flatten:               flattenAwsAccount,
+		schema: map[string]*schema.Schema{
+			"partition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "aws",
+				ValidateFunc: validation.StringInSlice([]string{"aws", "aws-us-gov", "aws-cn"}, false),
+				Description:  "AWS partition of the account: 'aws', 'aws-us-gov' or 'aws-cn'.",
+			},
+			"account_number": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAwsAccountNumber,
+				Description:  "AWS Account number to associate with Aviatrix account. Should be 12 digits.",
+			},
+			"iam": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "AWS IAM-role based flag.",
+			},
+			"role_app": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "AWS App role ARN.",
+			},
+			"role_ec2": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "AWS EC2 role ARN.",
+			},
+			"gateway_role_app": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"gateway_role_ec2"},
+				Description:  "AWS App role ARN for gateways.",
+			},
+			"gateway_role_ec2": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"gateway_role_app"},
+				Description:  "AWS EC2 role ARN for gateways.",
+			},
+			"access_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"secret_key"},
+				Description:  "AWS Access Key.",
+			},
+			"secret_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"access_key"},
+				Description:  "AWS Secret Key.",
+			},
+		},
+	})
+}
+
+func expandAwsAccount(get func(key string) interface{}, account *goaviatrix.Account) error {
+	partition := get("partition").(string)
+	accountNumber := get("account_number").(string)
+	iam := get("iam").(bool)
+	roleApp := get("role_app").(string)
+	roleEc2 := get("role_ec2").(string)
+	accessKey := get("access_key").(string)
+	secretKey := get("secret_key").(string)
+
+	if iam {
+		if accessKey != "" || secretKey != "" {
+			return fmt.Errorf("'access_key' and 'secret_key' can only be set when 'iam' is false")
+		}
+		if roleApp == "" {
+			roleApp = fmt.Sprintf("arn:%s:iam::%s:role/aviatrix-role-app", partition, accountNumber)
+		}
+		if roleEc2 == "" {
+			roleEc2 = fmt.Sprintf("arn:%s:iam::%s:role/aviatrix-role-ec2", partition, accountNumber)
+		}
+		account.AwsGatewayRoleApp = get("gateway_role_app").(string)
+		account.AwsGatewayRoleEc2 = get("gateway_role_ec2").(string)
+	} else {
+		if get("gateway_role_app").(string) != "" || get("gateway_role_ec2").(string) != "" {
+			return fmt.Errorf("'gateway_role_app' and 'gateway_role_ec2' can only be set when 'iam' is true")
+		}
+		// role_app and role_ec2 may still hold the computed roles from before iam was disabled
+		roleApp, roleEc2 = "", ""
+		if accessKey == "" || secretKey == "" {
+			return fmt.Errorf("'access_key' and 'secret_key' must be set when 'iam' is false")
+		}
+	}
+
+	account.CloudType = awsPartitionCloudTypes[partition]
+	switch account.CloudType {
+	case goaviatrix.AWS:
+		account.AwsAccountNumber = accountNumber
+		account.AwsIam = strconv.FormatBool(iam)
+		account.AwsRoleApp = roleApp
+		account.AwsRoleEc2 = roleEc2
+		account.AwsAccessKey = accessKey
+		account.AwsSecretKey = secretKey
+	case goaviatrix.AWSGov:
+		account.AwsgovAccountNumber = accountNumber
+		account.AwsgovIam = strconv.FormatBool(iam)
+		account.AwsgovRoleApp = roleApp
+		account.AwsgovRoleEc2 = roleEc2
+		account.AwsgovAccessKey = accessKey
+		account.AwsgovSecretKey = secretKey
+	case goaviatrix.AWSChina:
+		account.AwsChinaAccountNumber = accountNumber
+		account.AwsChinaIam = strconv.FormatBool(iam)
+		account.AwsChinaRoleApp = roleApp
+		account.AwsChinaRoleEc2 = roleEc2
+		account.AwsChinaAccessKey = accessKey
+		account.AwsChinaSecretKey = secretKey
+	}
+	return nil
+}
+
+func flattenAwsAccount(d *schema.ResourceData, account *goaviatrix.Account) {
+	var accountNumber, roleApp, roleEc2 string
+	switch {
+	case goaviatrix.IsCloudType(account.CloudType, goaviatrix.AWS):
+		d.Set("partition", "aws")
+		accountNumber, roleApp, roleEc2 = account.AwsAccountNumber, account.AwsRoleApp, account.AwsRoleEc2
+	case goaviatrix.IsCloudType(account.CloudType, goaviatrix.AWSGov):
+		d.Set("partition", "aws-us-gov")
+		accountNumber, roleApp, roleEc2 = account.AwsgovAccountNumber, account.AwsgovRoleApp, account.AwsgovRoleEc2
+	case goaviatrix.IsCloudType(account.CloudType, goaviatrix.AWSChina):
+		d.Set("partition", "aws-cn")
+		accountNumber, roleApp, roleEc2 = account.AwsChinaAccountNumber, account.AwsChinaRoleApp, account.AwsChinaRoleEc2
+	}
+
+	d.Set("account_number", accountNumber)
+	if roleEc2 != "" {
+		d.Set("access_key", "")
+		d.Set("secret_key", "")
+		d.Set("iam", true)
+		d.Set("role_app", roleApp)
+		d.Set("role_ec2", roleEc2)
+		d.Set("gateway_role_app", account.AwsGatewayRoleApp)
+		d.Set("gateway_role_ec2", account.AwsGatewayRoleEc2)
+	} else {
+		d.Set("iam", false)
+		d.Set("role_app", "")
+		d.Set("role_ec2", "")
+	}
+}
@@ -0,0 +1,117 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixAwsAccount_basic(t *testing.T) {
+	var account goaviatrix.Account
+
+	rInt := acctest.RandInt()
+	resourceName := "aviatrix_aws_account.test"
+
+	skipAcc := os.Getenv("SKIP_AWS_ACCOUNT")
+	if skipAcc == "yes" {
+		t.Skip("Skipping AWS Account test as SKIP_AWS_ACCOUNT is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preAccountCheck(t, ". Set SKIP_AWS_ACCOUNT to yes to skip AWS account tests")
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudAccountDestroy("aviatrix_aws_account"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAccountConfigBasic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountExists(resourceName, &account),
+					resource.TestCheckResourceAttr(resourceName, "account_name", fmt.Sprintf("tfa-aws-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "partition", "aws"),
+					resource.TestCheckResourceAttr(resourceName, "account_number", os.Getenv("AWS_ACCOUNT_NUMBER")),
+					resource.TestCheckResourceAttr(resourceName, "iam", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_key", "secret_key", "audit_account"},
+			},
+		},
+	})
+}
+
+func testAccAwsAccountConfigBasic(rInt int) string {
+	return fmt.Sprintf(`
+resource "aviatrix_aws_account" "test" {
+	account_name   = "tfa-aws-%d"
+	account_number = "%s"
+	access_key     = "%s"
+	secret_key     = "%s"
+}
+	`, rInt, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"))
+}
+
+func TestAwsAccountCustomizeDiff(t *testing.T) {
+	// Config value of an attribute that is only known during the apply
+	const unknownConfigValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+	tt := []struct {
+		Name        string
+		Config      map[string]interface{}
+		ExpectedErr string
+	}{
+		{
+			"access keys",
+			map[string]interface{}{"account_name": "aws", "account_number": "123456789012", "access_key": "key", "secret_key": "secret"},
+			"",
+		},
+		{
+			"iam",
+			map[string]interface{}{"account_name": "aws", "account_number": "123456789012", "iam": true},
+			"",
+		},
+		{
+			"access keys known during the apply",
+			map[string]interface{}{"account_name": "aws", "account_number": "123456789012", "access_key": unknownConfigValue, "secret_key": "secret"},
+			"",
+		},
+		{
+			"no access keys without iam",
+			map[string]interface{}{"account_name": "aws", "account_number": "123456789012"},
+			"'access_key' and 'secret_key' must be set when 'iam' is false",
+		},
+		{
+			"gateway roles without iam",
+			map[string]interface{}{"account_name": "aws", "account_number": "123456789012", "access_key": "key", "secret_key": "secret",
+				"gateway_role_app": "app", "gateway_role_ec2": "ec2"},
+			"'gateway_role_app' and 'gateway_role_ec2' can only be set when 'iam' is true",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := resourceAviatrixAwsAccount().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.Config), nil)
+			if tc.ExpectedErr == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %q", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.ExpectedErr) {
+				t.Fatalf("expected an error containing %q, got %v", tc.ExpectedErr, err)
+			}
+		})
+	}
+}
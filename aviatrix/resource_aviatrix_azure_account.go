@@ -0,0 +1,114 @@
+package aviatrix
+
+import (
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// azureEnvironmentCloudTypes maps the Azure environments to the cloud type of their accounts.
+var azureEnvironmentCloudTypes = map[string]int{
+	"AzureCloud":        goaviatrix.Azure,
+	"AzureUSGovernment": goaviatrix.AzureGov,
+	"AzureChinaCloud":   goaviatrix.AzureChina,
+}
+
+func resourceAviatrixAzureAccount() *schema.Resource {
+	return resourceAviatrixCloudAccount(cloudAccount{
+		name:                  "Azure",
+		cloudTypes:            goaviatrix.Azure | goaviatrix.AzureGov | goaviatrix.AzureChina,
+		credentialAttributes:  []string{"application_id", "application_key"},
+		restorableCredentials: true,
+		expand:                expandAzureAccount,
+		flatten:               flattenAzureAccount,
+		schema: map[string]*schema.Schema{
+			"environment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "AzureCloud",
+				ValidateFunc: validation.StringInSlice([]string{"AzureCloud", "AzureUSGovernment", "AzureChinaCloud"}, false),
+				Description:  "Azure environment of the account: 'AzureCloud', 'AzureUSGovernment' or 'AzureChinaCloud'.",
+			},
+			"subscription_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Azure subscription ID.",
+			},
+			"directory_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Azure directory ID.",
+			},
+			"application_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Azure application ID.",
+			},
+			"application_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Azure application key.",
+			},
+		},
+	})
+}
+
+func expandAzureAccount(get func(key string) interface{}, account *goaviatrix.Account) error {
+	subscriptionId := get("subscription_id").(string)
+	directoryId := get("directory_id").(string)
+	applicationId := get("application_id").(string)
+	applicationKey := get("application_key").(string)
+
+	account.CloudType = azureEnvironmentCloudTypes[get("environment").(string)]
+	switch account.CloudType {
+	case goaviatrix.Azure:
+		account.ArmSubscriptionId = subscriptionId
+		account.ArmApplicationEndpoint = directoryId
+		account.ArmApplicationClientId = applicationId
+		account.ArmApplicationClientSecret = applicationKey
+	case goaviatrix.AzureGov:
+		account.AzuregovSubscriptionId = subscriptionId
+		account.AzuregovApplicationEndpoint = directoryId
+		account.AzuregovApplicationClientId = applicationId
+		account.AzuregovApplicationClientSecret = applicationKey
+	case goaviatrix.AzureChina:
+		account.AzureChinaSubscriptionId = subscriptionId
+		account.AzureChinaApplicationEndpoint = directoryId
+		account.AzureChinaApplicationClientId = applicationId
+		account.AzureChinaApplicationClientSecret = applicationKey
+	}
+	return nil
+}
+
+func flattenAzureAccount(d *schema.ResourceData, account *goaviatrix.Account) {
+	var directoryId, applicationId string
+	switch {
+	case goaviatrix.IsCloudType(account.CloudType, goaviatrix.Azure):
+		d.Set("environment", "AzureCloud")
+		d.Set("subscription_id", account.ArmSubscriptionId)
+		directoryId, applicationId = account.ArmApplicationEndpoint, account.ArmApplicationClientId
+	case goaviatrix.IsCloudType(account.CloudType, goaviatrix.AzureGov):
+		d.Set("environment", "AzureUSGovernment")
+		d.Set("subscription_id", account.AzuregovSubscriptionId)
+		directoryId, applicationId = account.AzuregovApplicationEndpoint, account.AzuregovApplicationClientId
+	case goaviatrix.IsCloudType(account.CloudType, goaviatrix.AzureChina):
+		d.Set("environment", "AzureChinaCloud")
+		d.Set("subscription_id", account.AzureChinaSubscriptionId)
+		directoryId, applicationId = account.AzureChinaApplicationEndpoint, account.AzureChinaApplicationClientId
+	}
+	// Keep the configured values if the controller doesn't return them
+	if directoryId != "" {
+		d.Set("directory_id", directoryId)
+	}
+	if applicationId != "" {
+		d.Set("application_id", applicationId)
+	}
+}
@@ -0,0 +1,62 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAviatrixAzureAccount_basic(t *testing.T) {
+	var account goaviatrix.Account
+
+	rInt := acctest.RandInt()
+	resourceName := "aviatrix_azure_account.test"
+
+	skipAcc := os.Getenv("SKIP_AZURE_ACCOUNT")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Azure Account test as SKIP_AZURE_ACCOUNT is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preAccountCheck(t, ". Set SKIP_AZURE_ACCOUNT to yes to skip Azure account tests")
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudAccountDestroy("aviatrix_azure_account"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureAccountConfigBasic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountExists(resourceName, &account),
+					resource.TestCheckResourceAttr(resourceName, "account_name", fmt.Sprintf("tfa-azure-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "environment", "AzureCloud"),
+					resource.TestCheckResourceAttr(resourceName, "subscription_id", os.Getenv("ARM_SUBSCRIPTION_ID")),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"application_key", "audit_account"},
+			},
+		},
+	})
+}
+
+func testAccAzureAccountConfigBasic(rInt int) string {
+	return fmt.Sprintf(`
+resource "aviatrix_azure_account" "test" {
+	account_name    = "tfa-azure-%d"
+	subscription_id = "%s"
+	directory_id    = "%s"
+	application_id  = "%s"
+	application_key = "%s"
+}
+	`, rInt, os.Getenv("ARM_SUBSCRIPTION_ID"), os.Getenv("ARM_DIRECTORY_ID"),
+		os.Getenv("ARM_APPLICATION_ID"), os.Getenv("ARM_APPLICATION_KEY"))
+}
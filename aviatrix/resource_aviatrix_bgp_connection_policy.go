@@ -0,0 +1,395 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixBgpConnectionPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixBgpConnectionPolicyCreate,
+		ReadWithoutTimeout:   resourceAviatrixBgpConnectionPolicyRead,
+		UpdateWithoutTimeout: resourceAviatrixBgpConnectionPolicyUpdate,
+		DeleteWithoutTimeout: resourceAviatrixBgpConnectionPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceAviatrixBgpConnectionPolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"gw_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the transit gateway.",
+			},
+			"connection_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the BGP connection of the transit gateway.",
+			},
+			"prepend_as_path": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 25,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: goaviatrix.ValidateASN,
+				},
+				Description: "List of AS numbers to prepend to the AS_PATH of the routes advertised over the connection.",
+			},
+			"manual_bgp_advertised_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+				Description: "CIDRs advertised over the connection instead of the routes learned by the transit gateway.",
+			},
+			"inbound_prefix_filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+				Description: "Prefixes accepted from the BGP neighbors of the connection. All the prefixes are accepted if empty.",
+			},
+			"outbound_prefix_filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+				Description: "Prefixes advertised to the BGP neighbors of the connection. All the prefixes are advertised if empty.",
+			},
+			"bgp_keepalive_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      goaviatrix.DefaultBgpKeepaliveInterval,
+				ValidateFunc: validation.IntBetween(1, 120),
+				Description:  "BGP keepalive interval of the connection in seconds. Valid values are between 1 and 120. Default value: 60.",
+			},
+			"bgp_hold_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      goaviatrix.DefaultBgpHoldTime,
+				ValidateFunc: validation.IntBetween(12, 360),
+				Description: "BGP hold time of the connection in seconds. Must be at least three times 'bgp_keepalive_interval'. " +
+					"Valid values are between 12 and 360. Default value: 180.",
+			},
+			"bgp_md5_keys": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "BGP MD5 authentication keys of the connection, keyed by BGP neighbor IP.",
+			},
+			"enable_learned_cidrs_approval": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Enable learned CIDR approval for the connection. Requires the transit gateway's " +
+					"'learned_cidrs_approval_mode' to be set to 'connection'. Default value: false.",
+			},
+			"approved_learned_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+				Description: "Approved learned CIDRs of the connection. Requires 'enable_learned_cidrs_approval' to be true.",
+			},
+			"neighbor_ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IPs of the BGP neighbors of the connection.",
+			},
+		},
+	}
+}
+
+func resourceAviatrixBgpConnectionPolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	keepaliveInterval := d.Get("bgp_keepalive_interval").(int)
+	holdTime := d.Get("bgp_hold_time").(int)
+	if holdTime < 3*keepaliveInterval {
+		return fmt.Errorf("'bgp_hold_time' (%d) must be at least three times 'bgp_keepalive_interval' (%d)", holdTime, keepaliveInterval)
+	}
+
+	for neighborIP := range d.Get("bgp_md5_keys").(map[string]interface{}) {
+		if net.ParseIP(neighborIP) == nil {
+			return fmt.Errorf("'bgp_md5_keys' must be keyed by BGP neighbor IP, got %q", neighborIP)
+		}
+	}
+	return nil
+}
+
+func marshalBgpConnectionPolicyInput(d *schema.ResourceData) *goaviatrix.BgpConnectionPolicy {
+	return &goaviatrix.BgpConnectionPolicy{
+		GwName:                     d.Get("gw_name").(string),
+		ConnectionName:             d.Get("connection_name").(string),
+		PrependASPath:              getStringList(d, "prepend_as_path"),
+		ManualBgpAdvertisedCidrs:   getStringSet(d, "manual_bgp_advertised_cidrs"),
+		InboundPrefixFilter:        getStringSet(d, "inbound_prefix_filter"),
+		OutboundPrefixFilter:       getStringSet(d, "outbound_prefix_filter"),
+		KeepaliveInterval:          d.Get("bgp_keepalive_interval").(int),
+		HoldTime:                   d.Get("bgp_hold_time").(int),
+		EnableLearnedCidrsApproval: d.Get("enable_learned_cidrs_approval").(bool),
+		ApprovedLearnedCidrs:       getStringSet(d, "approved_learned_cidrs"),
+	}
+}
+
+// updateBgpMd5Keys sets the BGP MD5 keys of the neighbors whose key changed. The key of a
+// neighbor removed from the map is cleared.
+func updateBgpMd5Keys(client *goaviatrix.Client, gwName, connName string, oldKeys, newKeys map[string]interface{}, neighborIPs []string) error {
+	var changed []string
+	for neighborIP, key := range newKeys {
+		if oldKey, ok := oldKeys[neighborIP]; !ok || oldKey.(string) != key.(string) {
+			changed = append(changed, neighborIP)
+		}
+	}
+	for neighborIP := range oldKeys {
+		if _, ok := newKeys[neighborIP]; !ok {
+			changed = append(changed, neighborIP)
+		}
+	}
+	sort.Strings(changed)
+
+	for _, neighborIP := range changed {
+		if !goaviatrix.Contains(neighborIPs, neighborIP) {
+			return fmt.Errorf("%s is not a BGP neighbor of connection %s, valid neighbors are: %s", neighborIP, connName, strings.Join(neighborIPs, ", "))
+		}
+		key, _ := newKeys[neighborIP].(string)
+		err := client.EditBgpMd5Key(&goaviatrix.EditBgpMd5Key{
+			GwName:         gwName,
+			ConnectionName: connName,
+			BgpRemoteIP:    neighborIP,
+			BgpMd5Key:      key,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update BGP MD5 key of neighbor %s: %v", neighborIP, err)
+		}
+	}
+	return nil
+}
+
+func resourceAviatrixBgpConnectionPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	policy := marshalBgpConnectionPolicyInput(d)
+	if !policy.EnableLearnedCidrsApproval && len(policy.ApprovedLearnedCidrs) != 0 {
+		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+	}
+
+	current, err := client.GetBgpConnectionPolicy(ctx, policy.GwName, policy.ConnectionName)
+	if err != nil {
+		return diag.Errorf("failed to get BGP config of connection %s of transit gateway %s: %v", policy.ConnectionName, policy.GwName, err)
+	}
+
+	d.SetId(policy.GwName + "~" + policy.ConnectionName)
+	flag := false
+	defer resourceAviatrixBgpConnectionPolicyReadIfRequired(ctx, d, meta, &flag)
+
+	conn := &goaviatrix.ExternalDeviceConn{
+		GwName:         policy.GwName,
+		ConnectionName: policy.ConnectionName,
+	}
+	if err := client.EditTransitExternalDeviceConnASPathPrepend(conn, policy.PrependASPath); err != nil {
+		return diag.Errorf("failed to set prepend AS path of connection %s: %v", policy.ConnectionName, err)
+	}
+	if err := client.EditTransitConnectionBGPManualAdvertiseCIDRs(policy.GwName, policy.ConnectionName, policy.ManualBgpAdvertisedCidrs); err != nil {
+		return diag.Errorf("failed to set manual BGP advertised CIDRs of connection %s: %v", policy.ConnectionName, err)
+	}
+	if err := client.EditTransitConnectionBgpPrefixFilters(ctx, policy.GwName, policy.ConnectionName, policy.InboundPrefixFilter, policy.OutboundPrefixFilter); err != nil {
+		return diag.Errorf("failed to set BGP prefix filters of connection %s: %v", policy.ConnectionName, err)
+	}
+	if err := client.EditTransitConnectionBgpTimers(ctx, policy.GwName, policy.ConnectionName, policy.KeepaliveInterval, policy.HoldTime); err != nil {
+		return diag.Errorf("failed to set BGP timers of connection %s: %v", policy.ConnectionName, err)
+	}
+	if err := updateBgpMd5Keys(client, policy.GwName, policy.ConnectionName, nil, d.Get("bgp_md5_keys").(map[string]interface{}), current.NeighborIPs); err != nil {
+		return diag.Errorf("failed to set BGP MD5 keys of connection %s: %v", policy.ConnectionName, err)
+	}
+	if policy.EnableLearnedCidrsApproval {
+		if err := client.EnableTransitConnectionLearnedCIDRApproval(policy.GwName, policy.ConnectionName); err != nil {
+			return diag.Errorf("failed to enable learned CIDRs approval of connection %s: %v", policy.ConnectionName, err)
+		}
+		if len(policy.ApprovedLearnedCidrs) != 0 {
+			if err := client.UpdateTransitConnectionPendingApprovedCidrs(policy.GwName, policy.ConnectionName, policy.ApprovedLearnedCidrs); err != nil {
+				return diag.Errorf("failed to set approved learned CIDRs of connection %s: %v", policy.ConnectionName, err)
+			}
+		}
+	} else if current.EnableLearnedCidrsApproval {
+		if err := client.DisableTransitConnectionLearnedCIDRApproval(policy.GwName, policy.ConnectionName); err != nil {
+			return diag.Errorf("failed to disable learned CIDRs approval of connection %s: %v", policy.ConnectionName, err)
+		}
+	}
+
+	return resourceAviatrixBgpConnectionPolicyReadIfRequired(ctx, d, meta, &flag)
+}
+
+func resourceAviatrixBgpConnectionPolicyReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
+	if !(*flag) {
+		*flag = true
+		return resourceAviatrixBgpConnectionPolicyRead(ctx, d, meta)
+	}
+	return nil
+}
+
+func resourceAviatrixBgpConnectionPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+	connName := d.Get("connection_name").(string)
+	if gwName == "" || connName == "" {
+		id := d.Id()
+		parts := strings.Split(id, "~")
+		if len(parts) != 2 {
+			return diag.Errorf("invalid ID %q, expected gw_name~connection_name", id)
+		}
+		gwName, connName = parts[0], parts[1]
+		d.Set("gw_name", gwName)
+		d.Set("connection_name", connName)
+	}
+
+	policy, err := client.GetBgpConnectionPolicy(ctx, gwName, connName)
+	if err != nil {
+		if err == goaviatrix.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read BGP config of connection %s of transit gateway %s: %v", connName, gwName, err)
+	}
+
+	if err := d.Set("prepend_as_path", policy.PrependASPath); err != nil {
+		return diag.Errorf("failed to set prepend_as_path: %v", err)
+	}
+	if err := d.Set("manual_bgp_advertised_cidrs", policy.ManualBgpAdvertisedCidrs); err != nil {
+		return diag.Errorf("failed to set manual_bgp_advertised_cidrs: %v", err)
+	}
+	if err := d.Set("inbound_prefix_filter", policy.InboundPrefixFilter); err != nil {
+		return diag.Errorf("failed to set inbound_prefix_filter: %v", err)
+	}
+	if err := d.Set("outbound_prefix_filter", policy.OutboundPrefixFilter); err != nil {
+		return diag.Errorf("failed to set outbound_prefix_filter: %v", err)
+	}
+	d.Set("bgp_keepalive_interval", policy.KeepaliveInterval)
+	d.Set("bgp_hold_time", policy.HoldTime)
+	d.Set("enable_learned_cidrs_approval", policy.EnableLearnedCidrsApproval)
+	if err := d.Set("approved_learned_cidrs", policy.ApprovedLearnedCidrs); err != nil {
+		return diag.Errorf("failed to set approved_learned_cidrs: %v", err)
+	}
+	if err := d.Set("neighbor_ips", policy.NeighborIPs); err != nil {
+		return diag.Errorf("failed to set neighbor_ips: %v", err)
+	}
+
+	d.SetId(gwName + "~" + connName)
+	return nil
+}
+
+func resourceAviatrixBgpConnectionPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	policy := marshalBgpConnectionPolicyInput(d)
+	if !policy.EnableLearnedCidrsApproval && len(policy.ApprovedLearnedCidrs) != 0 && d.HasChange("approved_learned_cidrs") {
+		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+	}
+
+	if d.HasChange("prepend_as_path") {
+		conn := &goaviatrix.ExternalDeviceConn{
+			GwName:         policy.GwName,
+			ConnectionName: policy.ConnectionName,
+		}
+		if err := client.EditTransitExternalDeviceConnASPathPrepend(conn, policy.PrependASPath); err != nil {
+			return diag.Errorf("failed to update prepend AS path of connection %s: %v", policy.ConnectionName, err)
+		}
+	}
+	if d.HasChange("manual_bgp_advertised_cidrs") {
+		if err := client.EditTransitConnectionBGPManualAdvertiseCIDRs(policy.GwName, policy.ConnectionName, policy.ManualBgpAdvertisedCidrs); err != nil {
+			return diag.Errorf("failed to update manual BGP advertised CIDRs of connection %s: %v", policy.ConnectionName, err)
+		}
+	}
+	if d.HasChanges("inbound_prefix_filter", "outbound_prefix_filter") {
+		if err := client.EditTransitConnectionBgpPrefixFilters(ctx, policy.GwName, policy.ConnectionName, policy.InboundPrefixFilter, policy.OutboundPrefixFilter); err != nil {
+			return diag.Errorf("failed to update BGP prefix filters of connection %s: %v", policy.ConnectionName, err)
+		}
+	}
+	if d.HasChanges("bgp_keepalive_interval", "bgp_hold_time") {
+		if err := client.EditTransitConnectionBgpTimers(ctx, policy.GwName, policy.ConnectionName, policy.KeepaliveInterval, policy.HoldTime); err != nil {
+			return diag.Errorf("failed to update BGP timers of connection %s: %v", policy.ConnectionName, err)
+		}
+	}
+	if d.HasChange("bgp_md5_keys") {
+		oldKeys, newKeys := d.GetChange("bgp_md5_keys")
+		neighborIPs := getStringList(d, "neighbor_ips")
+		if err := updateBgpMd5Keys(client, policy.GwName, policy.ConnectionName, oldKeys.(map[string]interface{}), newKeys.(map[string]interface{}), neighborIPs); err != nil {
+			return diag.Errorf("failed to update BGP MD5 keys of connection %s: %v", policy.ConnectionName, err)
+		}
+	}
+	if d.HasChange("enable_learned_cidrs_approval") {
+		if policy.EnableLearnedCidrsApproval {
+			if err := client.EnableTransitConnectionLearnedCIDRApproval(policy.GwName, policy.ConnectionName); err != nil {
+				return diag.Errorf("failed to enable learned CIDRs approval of connection %s: %v", policy.ConnectionName, err)
+			}
+		} else {
+			if err := client.DisableTransitConnectionLearnedCIDRApproval(policy.GwName, policy.ConnectionName); err != nil {
+				return diag.Errorf("failed to disable learned CIDRs approval of connection %s: %v", policy.ConnectionName, err)
+			}
+		}
+	}
+	if d.HasChange("approved_learned_cidrs") && policy.EnableLearnedCidrsApproval {
+		if err := client.UpdateTransitConnectionPendingApprovedCidrs(policy.GwName, policy.ConnectionName, policy.ApprovedLearnedCidrs); err != nil {
+			return diag.Errorf("failed to update approved learned CIDRs of connection %s: %v", policy.ConnectionName, err)
+		}
+	}
+
+	return resourceAviatrixBgpConnectionPolicyRead(ctx, d, meta)
+}
+
+func resourceAviatrixBgpConnectionPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+	connName := d.Get("connection_name").(string)
+
+	// The BGP MD5 keys are left as is, since clearing them would tear down the BGP sessions of neighbors which use them.
+	conn := &goaviatrix.ExternalDeviceConn{
+		GwName:         gwName,
+		ConnectionName: connName,
+	}
+	if err := client.EditTransitExternalDeviceConnASPathPrepend(conn, nil); err != nil {
+		return diag.Errorf("failed to delete prepend AS path of connection %s: %v", connName, err)
+	}
+	if err := client.EditTransitConnectionBGPManualAdvertiseCIDRs(gwName, connName, nil); err != nil {
+		return diag.Errorf("failed to delete manual BGP advertised CIDRs of connection %s: %v", connName, err)
+	}
+	if err := client.EditTransitConnectionBgpPrefixFilters(ctx, gwName, connName, nil, nil); err != nil {
+		return diag.Errorf("failed to delete BGP prefix filters of connection %s: %v", connName, err)
+	}
+	if err := client.EditTransitConnectionBgpTimers(ctx, gwName, connName, goaviatrix.DefaultBgpKeepaliveInterval, goaviatrix.DefaultBgpHoldTime); err != nil {
+		return diag.Errorf("failed to reset BGP timers of connection %s: %v", connName, err)
+	}
+	if d.Get("enable_learned_cidrs_approval").(bool) {
+		if err := client.DisableTransitConnectionLearnedCIDRApproval(gwName, connName); err != nil {
+			return diag.Errorf("failed to disable learned CIDRs approval of connection %s: %v", connName, err)
+		}
+	}
+
+	return nil
+}
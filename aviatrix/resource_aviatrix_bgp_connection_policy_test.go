@@ -0,0 +1,149 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixBgpConnectionPolicy_basic(t *testing.T) {
+	if os.Getenv("SKIP_BGP_CONNECTION_POLICY") == "yes" {
+		t.Skip("Skipping BGP connection policy test as SKIP_BGP_CONNECTION_POLICY is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_bgp_connection_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, ". Set SKIP_BGP_CONNECTION_POLICY to yes to skip BGP connection policy tests")
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBgpConnectionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBgpConnectionPolicyBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBgpConnectionPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "prepend_as_path.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "manual_bgp_advertised_cidrs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inbound_prefix_filter.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_keepalive_interval", "30"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_hold_time", "90"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bgp_md5_keys"},
+			},
+		},
+	})
+}
+
+func testAccBgpConnectionPolicyBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test" {
+	account_name       = "tfa-%[1]s"
+	cloud_type         = 1
+	aws_account_number = "%[2]s"
+	aws_iam            = false
+	aws_access_key     = "%[3]s"
+	aws_secret_key     = "%[4]s"
+}
+
+resource "aviatrix_transit_gateway" "test" {
+	cloud_type      = 1
+	account_name    = aviatrix_account.test.account_name
+	gw_name         = "tfg-%[1]s"
+	vpc_id          = "%[5]s"
+	vpc_reg         = "%[6]s"
+	gw_size         = "t2.micro"
+	subnet          = "%[7]s"
+	local_as_number = "65001"
+}
+
+resource "aviatrix_transit_external_device_conn" "test" {
+	vpc_id                       = aviatrix_transit_gateway.test.vpc_id
+	connection_name              = "%[1]s"
+	gw_name                      = aviatrix_transit_gateway.test.gw_name
+	connection_type              = "bgp"
+	bgp_local_as_num             = "65001"
+	bgp_remote_as_num            = "65002"
+	remote_gateway_ip            = "172.12.13.14"
+	local_tunnel_cidr            = "169.254.70.1/30"
+	remote_tunnel_cidr           = "169.254.70.2/30"
+	manage_bgp_connection_policy = false
+}
+
+resource "aviatrix_bgp_connection_policy" "test" {
+	gw_name                     = aviatrix_transit_external_device_conn.test.gw_name
+	connection_name             = aviatrix_transit_external_device_conn.test.connection_name
+	prepend_as_path             = ["65001", "65001"]
+	manual_bgp_advertised_cidrs = ["10.10.0.0/16"]
+	inbound_prefix_filter       = ["192.168.0.0/16", "172.16.0.0/12"]
+	bgp_keepalive_interval      = 30
+	bgp_hold_time               = 90
+	bgp_md5_keys = {
+		"169.254.70.2" = "tfmd5-%[1]s"
+	}
+}
+`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"))
+}
+
+func testAccCheckBgpConnectionPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("BGP connection policy not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no BGP connection policy ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		policy, err := client.GetBgpConnectionPolicy(context.Background(), rs.Primary.Attributes["gw_name"], rs.Primary.Attributes["connection_name"])
+		if err != nil {
+			return err
+		}
+		if policy.GwName+"~"+policy.ConnectionName != rs.Primary.ID {
+			return fmt.Errorf("BGP connection policy ID mismatch")
+		}
+		return nil
+	}
+}
+
+func testAccCheckBgpConnectionPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_bgp_connection_policy" {
+			continue
+		}
+
+		parts := strings.Split(rs.Primary.ID, "~")
+		policy, err := client.GetBgpConnectionPolicy(context.Background(), parts[0], parts[1])
+		if err == goaviatrix.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if len(policy.PrependASPath) != 0 || len(policy.ManualBgpAdvertisedCidrs) != 0 || len(policy.InboundPrefixFilter) != 0 {
+			return fmt.Errorf("BGP connection policy still exists")
+		}
+	}
+
+	return nil
+}
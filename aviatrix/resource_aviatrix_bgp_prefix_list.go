@@ -0,0 +1,190 @@
+package aviatrix
+
+import (
+	"context"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixBgpPrefixList() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixBgpPrefixListCreate,
+		ReadWithoutTimeout:   resourceAviatrixBgpPrefixListRead,
+		UpdateWithoutTimeout: resourceAviatrixBgpPrefixListUpdate,
+		DeleteWithoutTimeout: resourceAviatrixBgpPrefixListDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceAviatrixBgpPrefixListCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the prefix list.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the prefix list.",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Rules of the prefix list, evaluated in increasing sequence number order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sequence": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+							Description:  "Sequence number of the rule. Must be unique within the prefix list.",
+						},
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(goaviatrix.BgpRouteMapActions, false),
+							Description:  "Action of the rule. Valid values: 'permit', 'deny'.",
+						},
+						"prefix": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsCIDR,
+							Description:  "Prefix matched by the rule.",
+						},
+						"ge": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 128),
+							Description:  "Minimum prefix length matched by the rule. Must be at least the length of 'prefix'.",
+						},
+						"le": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 128),
+							Description:  "Maximum prefix length matched by the rule. Must be at least 'ge'.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandBgpPrefixListRules(rules []interface{}) []*goaviatrix.BgpPrefixListRule {
+	var result []*goaviatrix.BgpPrefixListRule
+	for _, v := range rules {
+		rule := v.(map[string]interface{})
+		result = append(result, &goaviatrix.BgpPrefixListRule{
+			Sequence:     rule["sequence"].(int),
+			Action:       rule["action"].(string),
+			Prefix:       rule["prefix"].(string),
+			GeMaskLength: rule["ge"].(int),
+			LeMaskLength: rule["le"].(int),
+		})
+	}
+	return result
+}
+
+func flattenBgpPrefixListRules(rules []*goaviatrix.BgpPrefixListRule) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, rule := range rules {
+		result = append(result, map[string]interface{}{
+			"sequence": rule.Sequence,
+			"action":   rule.Action,
+			"prefix":   rule.Prefix,
+			"ge":       rule.GeMaskLength,
+			"le":       rule.LeMaskLength,
+		})
+	}
+	return result
+}
+
+func marshalBgpPrefixListInput(d *schema.ResourceData) *goaviatrix.BgpPrefixList {
+	return &goaviatrix.BgpPrefixList{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Rules:       expandBgpPrefixListRules(d.Get("rule").([]interface{})),
+	}
+}
+
+func resourceAviatrixBgpPrefixListCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("rule") {
+		return nil
+	}
+	prefixList := &goaviatrix.BgpPrefixList{
+		Name:  d.Get("name").(string),
+		Rules: expandBgpPrefixListRules(d.Get("rule").([]interface{})),
+	}
+	return prefixList.Validate()
+}
+
+func resourceAviatrixBgpPrefixListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	prefixList := marshalBgpPrefixListInput(d)
+	if err := client.CreateBgpPrefixList(ctx, prefixList); err != nil {
+		return diag.Errorf("failed to create BGP prefix list %s: %v", prefixList.Name, err)
+	}
+
+	d.SetId(prefixList.Name)
+	return resourceAviatrixBgpPrefixListRead(ctx, d, meta)
+}
+
+func resourceAviatrixBgpPrefixListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	name := d.Get("name").(string)
+	if name == "" {
+		name = d.Id()
+		d.Set("name", name)
+	}
+
+	prefixList, err := client.GetBgpPrefixList(ctx, name)
+	if err != nil {
+		if err == goaviatrix.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read BGP prefix list %s: %v", name, err)
+	}
+
+	d.Set("description", prefixList.Description)
+	if err := d.Set("rule", flattenBgpPrefixListRules(prefixList.Rules)); err != nil {
+		return diag.Errorf("failed to set rule: %v", err)
+	}
+
+	d.SetId(name)
+	return nil
+}
+
+func resourceAviatrixBgpPrefixListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if d.HasChanges("description", "rule") {
+		prefixList := marshalBgpPrefixListInput(d)
+		if err := client.UpdateBgpPrefixList(ctx, prefixList); err != nil {
+			return diag.Errorf("failed to update BGP prefix list %s: %v", prefixList.Name, err)
+		}
+	}
+
+	return resourceAviatrixBgpPrefixListRead(ctx, d, meta)
+}
+
+func resourceAviatrixBgpPrefixListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	name := d.Get("name").(string)
+	if err := client.DeleteBgpPrefixList(ctx, name); err != nil {
+		return diag.Errorf("failed to delete BGP prefix list %s: %v", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixBgpPrefixList_basic(t *testing.T) {
+	if os.Getenv("SKIP_BGP_PREFIX_LIST") == "yes" {
+		t.Skip("Skipping BGP prefix list test as SKIP_BGP_PREFIX_LIST is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_bgp_prefix_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBgpPrefixListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBgpPrefixListBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBgpPrefixListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "tfpl-"+rName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.prefix", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.le", "24"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.action", "deny"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBgpPrefixListBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_bgp_prefix_list" "test" {
+	name        = "tfpl-%s"
+	description = "on-prem prefixes"
+
+	rule {
+		sequence = 10
+		action   = "permit"
+		prefix   = "10.0.0.0/8"
+		le       = 24
+	}
+
+	rule {
+		sequence = 20
+		action   = "deny"
+		prefix   = "0.0.0.0/0"
+		le       = 32
+	}
+}
+`, rName)
+}
+
+func testAccCheckBgpPrefixListExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("BGP prefix list not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no BGP prefix list ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		prefixList, err := client.GetBgpPrefixList(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if prefixList.Name != rs.Primary.ID {
+			return fmt.Errorf("BGP prefix list not found")
+		}
+		return nil
+	}
+}
+
+func testAccCheckBgpPrefixListDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_bgp_prefix_list" {
+			continue
+		}
+
+		_, err := client.GetBgpPrefixList(context.Background(), rs.Primary.ID)
+		if err != goaviatrix.ErrNotFound {
+			return fmt.Errorf("BGP prefix list still exists")
+		}
+	}
+
+	return nil
+}
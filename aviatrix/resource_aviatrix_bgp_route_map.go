@@ -0,0 +1,225 @@
+package aviatrix
+
+import (
+	"context"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixBgpRouteMap() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixBgpRouteMapCreate,
+		ReadWithoutTimeout:   resourceAviatrixBgpRouteMapRead,
+		UpdateWithoutTimeout: resourceAviatrixBgpRouteMapUpdate,
+		DeleteWithoutTimeout: resourceAviatrixBgpRouteMapDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceAviatrixBgpRouteMapCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the route map.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the route map.",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Rules of the route map, evaluated in increasing sequence number order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sequence": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+							Description:  "Sequence number of the rule. Must be unique within the route map.",
+						},
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(goaviatrix.BgpRouteMapActions, false),
+							Description:  "Action of the rule. Valid values: 'permit', 'deny'.",
+						},
+						"match_prefix_list": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the prefix list the routes must match. All the routes match if empty.",
+						},
+						"match_communities": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "BGP communities the routes must carry, in the ASN:value format or a well known community.",
+						},
+						"set_local_preference": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Local preference set on the matching routes. Not modified if 0.",
+						},
+						"set_med": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Multi-exit discriminator set on the matching routes. Not modified if 0.",
+						},
+						"set_communities": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "BGP communities set on the matching routes, in the ASN:value format or a well known community.",
+						},
+						"set_community_additive": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Add 'set_communities' to the communities of the matching routes instead of replacing them.",
+						},
+						"set_prepend_as_path": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 25,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: goaviatrix.ValidateASN,
+							},
+							Description: "AS numbers prepended to the AS_PATH of the matching routes.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandBgpRouteMapRules(rules []interface{}) []*goaviatrix.BgpRouteMapRule {
+	var result []*goaviatrix.BgpRouteMapRule
+	for _, v := range rules {
+		rule := v.(map[string]interface{})
+		result = append(result, &goaviatrix.BgpRouteMapRule{
+			Sequence:             rule["sequence"].(int),
+			Action:               rule["action"].(string),
+			MatchPrefixList:      rule["match_prefix_list"].(string),
+			MatchCommunities:     goaviatrix.ExpandStringList(rule["match_communities"].([]interface{})),
+			SetLocalPreference:   rule["set_local_preference"].(int),
+			SetMed:               rule["set_med"].(int),
+			SetCommunities:       goaviatrix.ExpandStringList(rule["set_communities"].([]interface{})),
+			SetCommunityAdditive: rule["set_community_additive"].(bool),
+			SetPrependASPath:     goaviatrix.ExpandStringList(rule["set_prepend_as_path"].([]interface{})),
+		})
+	}
+	return result
+}
+
+func flattenBgpRouteMapRules(rules []*goaviatrix.BgpRouteMapRule) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, rule := range rules {
+		result = append(result, map[string]interface{}{
+			"sequence":               rule.Sequence,
+			"action":                 rule.Action,
+			"match_prefix_list":      rule.MatchPrefixList,
+			"match_communities":      rule.MatchCommunities,
+			"set_local_preference":   rule.SetLocalPreference,
+			"set_med":                rule.SetMed,
+			"set_communities":        rule.SetCommunities,
+			"set_community_additive": rule.SetCommunityAdditive,
+			"set_prepend_as_path":    rule.SetPrependASPath,
+		})
+	}
+	return result
+}
+
+func marshalBgpRouteMapInput(d *schema.ResourceData) *goaviatrix.BgpRouteMap {
+	return &goaviatrix.BgpRouteMap{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Rules:       expandBgpRouteMapRules(d.Get("rule").([]interface{})),
+	}
+}
+
+func resourceAviatrixBgpRouteMapCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("rule") {
+		return nil
+	}
+	routeMap := &goaviatrix.BgpRouteMap{
+		Name:  d.Get("name").(string),
+		Rules: expandBgpRouteMapRules(d.Get("rule").([]interface{})),
+	}
+	return routeMap.Validate()
+}
+
+func resourceAviatrixBgpRouteMapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	routeMap := marshalBgpRouteMapInput(d)
+	if err := client.CreateBgpRouteMap(ctx, routeMap); err != nil {
+		return diag.Errorf("failed to create BGP route map %s: %v", routeMap.Name, err)
+	}
+
+	d.SetId(routeMap.Name)
+	return resourceAviatrixBgpRouteMapRead(ctx, d, meta)
+}
+
+func resourceAviatrixBgpRouteMapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	name := d.Get("name").(string)
+	if name == "" {
+		name = d.Id()
+		d.Set("name", name)
+	}
+
+	routeMap, err := client.GetBgpRouteMap(ctx, name)
+	if err != nil {
+		if err == goaviatrix.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read BGP route map %s: %v", name, err)
+	}
+
+	d.Set("description", routeMap.Description)
+	if err := d.Set("rule", flattenBgpRouteMapRules(routeMap.Rules)); err != nil {
+		return diag.Errorf("failed to set rule: %v", err)
+	}
+
+	d.SetId(name)
+	return nil
+}
+
+func resourceAviatrixBgpRouteMapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if d.HasChanges("description", "rule") {
+		routeMap := marshalBgpRouteMapInput(d)
+		if err := client.UpdateBgpRouteMap(ctx, routeMap); err != nil {
+			return diag.Errorf("failed to update BGP route map %s: %v", routeMap.Name, err)
+		}
+	}
+
+	return resourceAviatrixBgpRouteMapRead(ctx, d, meta)
+}
+
+func resourceAviatrixBgpRouteMapDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	name := d.Get("name").(string)
+	if err := client.DeleteBgpRouteMap(ctx, name); err != nil {
+		return diag.Errorf("failed to delete BGP route map %s: %v", name, err)
+	}
+
+	return nil
+}
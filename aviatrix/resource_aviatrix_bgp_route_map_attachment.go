@@ -0,0 +1,170 @@
+package aviatrix
+
+import (
+	"context"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixBgpRouteMapAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixBgpRouteMapAttachmentCreate,
+		ReadWithoutTimeout:   resourceAviatrixBgpRouteMapAttachmentRead,
+		UpdateWithoutTimeout: resourceAviatrixBgpRouteMapAttachmentUpdate,
+		DeleteWithoutTimeout: resourceAviatrixBgpRouteMapAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"gw_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the transit or spoke gateway.",
+			},
+			"connection_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"connection_name", "peer_gw_name"},
+				Description:  "Name of the external device connection of the gateway.",
+			},
+			"peer_gw_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"connection_name", "peer_gw_name"},
+				Description:  "Name of the peer transit gateway of a transit peering of the gateway.",
+			},
+			"inbound_route_map": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"inbound_route_map", "outbound_route_map"},
+				Description:  "Name of the route map applied to the routes received from the BGP neighbors of the connection.",
+			},
+			"outbound_route_map": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"inbound_route_map", "outbound_route_map"},
+				Description:  "Name of the route map applied to the routes advertised to the BGP neighbors of the connection.",
+			},
+		},
+	}
+}
+
+func marshalBgpRouteMapAttachmentInput(d *schema.ResourceData) *goaviatrix.BgpRouteMapAttachment {
+	return &goaviatrix.BgpRouteMapAttachment{
+		GwName:           d.Get("gw_name").(string),
+		ConnectionName:   d.Get("connection_name").(string),
+		PeerGwName:       d.Get("peer_gw_name").(string),
+		InboundRouteMap:  d.Get("inbound_route_map").(string),
+		OutboundRouteMap: d.Get("outbound_route_map").(string),
+	}
+}
+
+func resourceAviatrixBgpRouteMapAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	attachment := marshalBgpRouteMapAttachmentInput(d)
+
+	d.SetId(strings.Join([]string{attachment.GwName, attachment.ConnectionName, attachment.PeerGwName}, "~"))
+	flag := false
+	defer resourceAviatrixBgpRouteMapAttachmentReadIfRequired(ctx, d, meta, &flag)
+
+	if attachment.InboundRouteMap != "" {
+		if err := client.AttachBgpRouteMap(ctx, attachment, goaviatrix.BgpRouteMapDirectionInbound, attachment.InboundRouteMap); err != nil {
+			return diag.Errorf("failed to attach inbound BGP route map %s: %v", attachment.InboundRouteMap, err)
+		}
+	}
+	if attachment.OutboundRouteMap != "" {
+		if err := client.AttachBgpRouteMap(ctx, attachment, goaviatrix.BgpRouteMapDirectionOutbound, attachment.OutboundRouteMap); err != nil {
+			return diag.Errorf("failed to attach outbound BGP route map %s: %v", attachment.OutboundRouteMap, err)
+		}
+	}
+
+	return resourceAviatrixBgpRouteMapAttachmentReadIfRequired(ctx, d, meta, &flag)
+}
+
+func resourceAviatrixBgpRouteMapAttachmentReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
+	if !(*flag) {
+		*flag = true
+		return resourceAviatrixBgpRouteMapAttachmentRead(ctx, d, meta)
+	}
+	return nil
+}
+
+func resourceAviatrixBgpRouteMapAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if d.Get("gw_name").(string) == "" {
+		id := d.Id()
+		parts := strings.Split(id, "~")
+		if len(parts) != 3 || parts[0] == "" || (parts[1] == "") == (parts[2] == "") {
+			return diag.Errorf("invalid ID %q, expected gw_name~connection_name~ or gw_name~~peer_gw_name", id)
+		}
+		d.Set("gw_name", parts[0])
+		d.Set("connection_name", parts[1])
+		d.Set("peer_gw_name", parts[2])
+	}
+
+	attachment, err := client.GetBgpRouteMapAttachment(ctx, marshalBgpRouteMapAttachmentInput(d))
+	if err != nil {
+		if err == goaviatrix.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read BGP route map attachment %s: %v", d.Id(), err)
+	}
+	if attachment.InboundRouteMap == "" && attachment.OutboundRouteMap == "" {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("inbound_route_map", attachment.InboundRouteMap)
+	d.Set("outbound_route_map", attachment.OutboundRouteMap)
+
+	d.SetId(strings.Join([]string{attachment.GwName, attachment.ConnectionName, attachment.PeerGwName}, "~"))
+	return nil
+}
+
+func resourceAviatrixBgpRouteMapAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	attachment := marshalBgpRouteMapAttachmentInput(d)
+	if d.HasChange("inbound_route_map") {
+		if err := client.AttachBgpRouteMap(ctx, attachment, goaviatrix.BgpRouteMapDirectionInbound, attachment.InboundRouteMap); err != nil {
+			return diag.Errorf("failed to update inbound BGP route map: %v", err)
+		}
+	}
+	if d.HasChange("outbound_route_map") {
+		if err := client.AttachBgpRouteMap(ctx, attachment, goaviatrix.BgpRouteMapDirectionOutbound, attachment.OutboundRouteMap); err != nil {
+			return diag.Errorf("failed to update outbound BGP route map: %v", err)
+		}
+	}
+
+	return resourceAviatrixBgpRouteMapAttachmentRead(ctx, d, meta)
+}
+
+func resourceAviatrixBgpRouteMapAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	attachment := marshalBgpRouteMapAttachmentInput(d)
+	if attachment.InboundRouteMap != "" {
+		if err := client.AttachBgpRouteMap(ctx, attachment, goaviatrix.BgpRouteMapDirectionInbound, ""); err != nil {
+			return diag.Errorf("failed to detach inbound BGP route map %s: %v", attachment.InboundRouteMap, err)
+		}
+	}
+	if attachment.OutboundRouteMap != "" {
+		if err := client.AttachBgpRouteMap(ctx, attachment, goaviatrix.BgpRouteMapDirectionOutbound, ""); err != nil {
+			return diag.Errorf("failed to detach outbound BGP route map %s: %v", attachment.OutboundRouteMap, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixBgpRouteMapAttachment_basic(t *testing.T) {
+	if os.Getenv("SKIP_BGP_ROUTE_MAP_ATTACHMENT") == "yes" {
+		t.Skip("Skipping BGP route map attachment test as SKIP_BGP_ROUTE_MAP_ATTACHMENT is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_bgp_route_map_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, ". Set SKIP_BGP_ROUTE_MAP_ATTACHMENT to yes to skip BGP route map attachment tests")
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBgpRouteMapAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBgpRouteMapAttachmentBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBgpRouteMapAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connection_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "inbound_route_map", "tfrm-"+rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBgpRouteMapAttachmentBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test" {
+	account_name       = "tfa-%[1]s"
+	cloud_type         = 1
+	aws_account_number = "%[2]s"
+	aws_iam            = false
+	aws_access_key     = "%[3]s"
+	aws_secret_key     = "%[4]s"
+}
+
+resource "aviatrix_transit_gateway" "test" {
+	cloud_type      = 1
+	account_name    = aviatrix_account.test.account_name
+	gw_name         = "tfg-%[1]s"
+	vpc_id          = "%[5]s"
+	vpc_reg         = "%[6]s"
+	gw_size         = "t2.micro"
+	subnet          = "%[7]s"
+	local_as_number = "65001"
+}
+
+resource "aviatrix_transit_external_device_conn" "test" {
+	vpc_id            = aviatrix_transit_gateway.test.vpc_id
+	connection_name   = "%[1]s"
+	gw_name           = aviatrix_transit_gateway.test.gw_name
+	connection_type   = "bgp"
+	bgp_local_as_num  = "65001"
+	bgp_remote_as_num = "65002"
+	remote_gateway_ip = "172.12.13.14"
+}
+
+resource "aviatrix_bgp_route_map" "test" {
+	name = "tfrm-%[1]s"
+
+	rule {
+		sequence             = 10
+		action               = "permit"
+		set_local_preference = 200
+	}
+}
+
+resource "aviatrix_bgp_route_map_attachment" "test" {
+	gw_name           = aviatrix_transit_external_device_conn.test.gw_name
+	connection_name   = aviatrix_transit_external_device_conn.test.connection_name
+	inbound_route_map = aviatrix_bgp_route_map.test.name
+}
+`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"))
+}
+
+func testAccCheckBgpRouteMapAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("BGP route map attachment not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no BGP route map attachment ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		attachment, err := client.GetBgpRouteMapAttachment(context.Background(), &goaviatrix.BgpRouteMapAttachment{
+			GwName:         rs.Primary.Attributes["gw_name"],
+			ConnectionName: rs.Primary.Attributes["connection_name"],
+			PeerGwName:     rs.Primary.Attributes["peer_gw_name"],
+		})
+		if err != nil {
+			return err
+		}
+		if attachment.InboundRouteMap != rs.Primary.Attributes["inbound_route_map"] {
+			return fmt.Errorf("BGP route map attachment mismatch: expected inbound route map %s, got %s",
+				rs.Primary.Attributes["inbound_route_map"], attachment.InboundRouteMap)
+		}
+		return nil
+	}
+}
+
+func testAccCheckBgpRouteMapAttachmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_bgp_route_map_attachment" {
+			continue
+		}
+
+		attachment, err := client.GetBgpRouteMapAttachment(context.Background(), &goaviatrix.BgpRouteMapAttachment{
+			GwName:         rs.Primary.Attributes["gw_name"],
+			ConnectionName: rs.Primary.Attributes["connection_name"],
+			PeerGwName:     rs.Primary.Attributes["peer_gw_name"],
+		})
+		if err == goaviatrix.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if attachment.InboundRouteMap != "" || attachment.OutboundRouteMap != "" {
+			return fmt.Errorf("BGP route map attachment still exists")
+		}
+	}
+
+	return nil
+}
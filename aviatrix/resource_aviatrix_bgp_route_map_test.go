@@ -0,0 +1,123 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixBgpRouteMap_basic(t *testing.T) {
+	if os.Getenv("SKIP_BGP_ROUTE_MAP") == "yes" {
+		t.Skip("Skipping BGP route map test as SKIP_BGP_ROUTE_MAP is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_bgp_route_map.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBgpRouteMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBgpRouteMapBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBgpRouteMapExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "tfrm-"+rName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.match_prefix_list", "tfpl-"+rName),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.set_local_preference", "200"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.set_communities.0", "65001:100"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.set_med", "50"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBgpRouteMapBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_bgp_prefix_list" "test" {
+	name = "tfpl-%[1]s"
+
+	rule {
+		sequence = 10
+		action   = "permit"
+		prefix   = "10.0.0.0/8"
+		le       = 24
+	}
+}
+
+resource "aviatrix_bgp_route_map" "test" {
+	name = "tfrm-%[1]s"
+
+	rule {
+		sequence               = 10
+		action                 = "permit"
+		match_prefix_list      = aviatrix_bgp_prefix_list.test.name
+		set_local_preference   = 200
+		set_communities        = ["65001:100"]
+		set_community_additive = true
+	}
+
+	rule {
+		sequence = 20
+		action   = "permit"
+		set_med  = 50
+	}
+}
+`, rName)
+}
+
+func testAccCheckBgpRouteMapExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("BGP route map not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no BGP route map ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		routeMap, err := client.GetBgpRouteMap(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if routeMap.Name != rs.Primary.ID {
+			return fmt.Errorf("BGP route map not found")
+		}
+		return nil
+	}
+}
+
+func testAccCheckBgpRouteMapDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_bgp_route_map" {
+			continue
+		}
+
+		_, err := client.GetBgpRouteMap(context.Background(), rs.Primary.ID)
+		if err != goaviatrix.ErrNotFound {
+			return fmt.Errorf("BGP route map still exists")
+		}
+	}
+
+	return nil
+}
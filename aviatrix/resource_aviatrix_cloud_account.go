@@ -0,0 +1,299 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cloudAccount describes an account resource of a single cloud, such as aviatrix_aws_account. All of these
+// resources share the same CRUD functions and differ only in their cloud specific attributes.
+type cloudAccount struct {
+	// Name of the cloud in messages
+	name string
+	// Cloud types of the accounts managed by the resource
+	cloudTypes int
+	// Cloud specific attributes, in addition to the attributes common to all accounts
+	schema map[string]*schema.Schema
+	// Attributes holding the credentials, verified by an account audit when they change
+	credentialAttributes []string
+	// Whether the previous credentials can be restored if the audit of new credentials fails
+	restorableCredentials bool
+
+	// expand sets the cloud type and cloud specific fields of the account from the attribute values
+	// returned by get, and validates them
+	expand func(get func(key string) interface{}, account *goaviatrix.Account) error
+	// flatten sets the cloud specific attributes from the account read from the controller
+	flatten func(d *schema.ResourceData, account *goaviatrix.Account)
+	// create and update default to CreateAccount and UpdateAccount
+	create func(client *goaviatrix.Client, account *goaviatrix.Account) error
+	update func(client *goaviatrix.Client, account *goaviatrix.Account) error
+}
+
+func resourceAviatrixCloudAccount(cloud cloudAccount) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"account_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Account name. This can be used for logging in to CloudN console or UserConnect controller.",
+		},
+		"audit_account": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Enable account audit.",
+		},
+		"verify_credential_rotation": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+			Description: "Audit the account right after its credentials change and restore the previous " +
+				"credentials if the audit fails.",
+		},
+		"rbac_groups": {
+			Type:     schema.TypeList,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Optional: true,
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				groupNameOld, _ := d.GetChange("rbac_groups")
+				return len(groupNameOld.([]interface{})) != 0
+			},
+			Description: "List of RBAC permission group names.",
+		},
+	}
+	for k, v := range cloud.schema {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceAviatrixCloudAccountCreate(ctx, d, meta, cloud)
+		},
+		ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceAviatrixCloudAccountRead(ctx, d, meta, cloud)
+		},
+		UpdateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceAviatrixCloudAccountUpdate(ctx, d, meta, cloud)
+		},
+		DeleteWithoutTimeout: resourceAviatrixAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return resourceAviatrixCloudAccountCustomizeDiff(d, cloud)
+		},
+
+		Schema: s,
+	}
+}
+
+// resourceAviatrixCloudAccountCustomizeDiff runs the cloud specific validation of expand during the plan,
+// whenever the apply would run it, so invalid attributes don't fail halfway through an apply.
+func resourceAviatrixCloudAccountCustomizeDiff(d *schema.ResourceDiff, cloud cloudAccount) error {
+	rawConfig := d.GetRawConfig()
+	var cloudAttributes []string
+	for k, v := range cloud.schema {
+		// Values only known during the apply are validated then. Computed attributes are unknown whenever
+		// they are not configured, so only their configured value is checked.
+		known := d.NewValueKnown(k)
+		if v.Computed {
+			known = !rawConfig.Type().IsObjectType() || rawConfig.GetAttr(k).IsKnown()
+		}
+		if !known {
+			return nil
+		}
+		cloudAttributes = append(cloudAttributes, k)
+	}
+	if d.Id() != "" && !d.HasChanges(cloudAttributes...) {
+		return nil
+	}
+
+	account := &goaviatrix.Account{
+		AccountName: d.Get("account_name").(string),
+	}
+	if err := cloud.expand(d.Get, account); err != nil {
+		return fmt.Errorf("invalid Aviatrix %s Account: %v", cloud.name, err)
+	}
+	return nil
+}
+
+func resourceAviatrixCloudAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, cloud cloudAccount) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	account := &goaviatrix.Account{
+		AccountName: d.Get("account_name").(string),
+	}
+	if err := cloud.expand(d.Get, account); err != nil {
+		return diag.Errorf("could not create Aviatrix %s Account: %v", cloud.name, err)
+	}
+	if _, ok := d.GetOk("rbac_groups"); ok {
+		account.GroupNames = strings.Join(goaviatrix.ExpandStringList(d.Get("rbac_groups").([]interface{})), ",")
+	}
+
+	log.Printf("[INFO] Creating Aviatrix %s account: %s", cloud.name, account.AccountName)
+
+	var err error
+	if cloud.create != nil {
+		err = cloud.create(client, account)
+	} else {
+		err = client.CreateAccount(account)
+	}
+	if _, ok := err.(goaviatrix.DuplicateError); ok {
+		return diag.Errorf("failed to create Aviatrix %s Account: %s", cloud.name, err)
+	}
+
+	d.SetId(account.AccountName)
+	flag := false
+	defer resourceAviatrixCloudAccountReadIfRequired(ctx, d, meta, cloud, &flag)
+	if err != nil {
+		return diag.Errorf("failed to create Aviatrix %s Account: %s", cloud.name, err)
+	}
+
+	return resourceAviatrixCloudAccountReadIfRequired(ctx, d, meta, cloud, &flag)
+}
+
+func resourceAviatrixCloudAccountReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, cloud cloudAccount, flag *bool) diag.Diagnostics {
+	if !(*flag) {
+		*flag = true
+		return resourceAviatrixCloudAccountRead(ctx, d, meta, cloud)
+	}
+	return nil
+}
+
+func resourceAviatrixCloudAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}, cloud cloudAccount) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	var diags diag.Diagnostics
+
+	accountName := d.Get("account_name").(string)
+	isImport := accountName == ""
+	if isImport {
+		id := d.Id()
+		log.Printf("[DEBUG] Looks like an import, no account name received. Import Id is %s", id)
+		d.Set("account_name", id)
+		d.Set("verify_credential_rotation", true)
+		d.SetId(id)
+	}
+
+	account := &goaviatrix.Account{
+		AccountName: d.Get("account_name").(string),
+	}
+
+	acc, err := client.GetAccount(account)
+	if err != nil {
+		if err == goaviatrix.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("aviatrix Account: %s", err)
+	}
+	if !goaviatrix.IsCloudType(acc.CloudType, cloud.cloudTypes) {
+		return diag.Errorf("Aviatrix Account %s has cloud type %d and is not a %s account", acc.AccountName, acc.CloudType, cloud.name)
+	}
+
+	d.Set("account_name", acc.AccountName)
+	cloud.flatten(d, acc)
+	d.Set("rbac_groups", acc.GroupNamesRead)
+	d.SetId(acc.AccountName)
+
+	// Don't check account audit during import, see resourceAviatrixAccountRead
+	if d.Get("audit_account").(bool) && !isImport {
+		err = client.AuditAccount(ctx, account)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Aviatrix Account failed audit",
+				Detail:   fmt.Sprintf("%v", err),
+			})
+		}
+	}
+
+	return diags
+}
+
+func resourceAviatrixCloudAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, cloud cloudAccount) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	d.Partial(true)
+
+	var cloudAttributes []string
+	for k := range cloud.schema {
+		cloudAttributes = append(cloudAttributes, k)
+	}
+	if d.HasChanges(cloudAttributes...) {
+		account := &goaviatrix.Account{
+			AccountName: d.Get("account_name").(string),
+		}
+		if err := cloud.expand(d.Get, account); err != nil {
+			return diag.Errorf("could not update Aviatrix %s Account: %v", cloud.name, err)
+		}
+
+		var previous func() *goaviatrix.Account
+		if cloud.restorableCredentials {
+			previous = func() *goaviatrix.Account {
+				account := &goaviatrix.Account{
+					AccountName: d.Get("account_name").(string),
+				}
+				// The previous values were valid when they were applied
+				_ = cloud.expand(oldResourceDataGetter(d), account)
+				return account
+			}
+		}
+		update := client.UpdateAccount
+		if cloud.update != nil {
+			update = func(account *goaviatrix.Account) error {
+				return cloud.update(client, account)
+			}
+		}
+
+		log.Printf("[INFO] Updating Aviatrix %s account: %s", cloud.name, account.AccountName)
+		err := updateAccountCredentials(ctx, d, client, account, cloud.credentialAttributes, previous, update)
+		if err != nil {
+			return diag.Errorf("failed to update Aviatrix %s Account: %s", cloud.name, err)
+		}
+	}
+
+	d.Partial(false)
+	return resourceAviatrixCloudAccountRead(ctx, d, meta, cloud)
+}
+
+// updateAccountCredentials updates an account using update. If verify_credential_rotation is set and any of
+// the credential attributes changed, the account is audited right after and the account returned by
+// previous, if any, is restored if the audit fails. Credentials which were empty before, as after an import,
+// are not rotated.
+func updateAccountCredentials(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, account *goaviatrix.Account,
+	credentialAttributes []string, previous func() *goaviatrix.Account, update func(*goaviatrix.Account) error) error {
+	if !d.Get("verify_credential_rotation").(bool) || !credentialsRotated(d, credentialAttributes) {
+		return update(account)
+	}
+
+	log.Printf("[INFO] Rotating credentials of Aviatrix account: %s", account.AccountName)
+	var previousAccount *goaviatrix.Account
+	if previous != nil {
+		previousAccount = previous()
+	}
+	return client.RotateAccountCredentials(ctx, account, previousAccount, update)
+}
+
+func credentialsRotated(d *schema.ResourceData, credentialAttributes []string) bool {
+	for _, k := range credentialAttributes {
+		if old, _ := d.GetChange(k); d.HasChange(k) && old.(string) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// oldResourceDataGetter returns a getter of the previous attribute values during an update.
+func oldResourceDataGetter(d *schema.ResourceData) func(key string) interface{} {
+	return func(key string) interface{} {
+		old, _ := d.GetChange(key)
+		return old
+	}
+}
@@ -0,0 +1,133 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAviatrixControllerBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixControllerBackupCreate,
+		ReadWithoutTimeout:   resourceAviatrixControllerBackupRead,
+		DeleteWithoutTimeout: resourceAviatrixControllerBackupDelete,
+
+		Schema: map[string]*schema.Schema{
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, will trigger a new backup.",
+			},
+			"file_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "File name of the backup in the backup location.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the backup.",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the backup in bytes.",
+			},
+			"cloud_type": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Cloud type of the backup location.",
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Access account of the backup location.",
+			},
+			"bucket_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Bucket of the backup location for AWS, AWSGov, GCP and OCI.",
+			},
+			"storage_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Storage account of the backup location for Azure.",
+			},
+			"container_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Container of the backup location for Azure.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Region of the backup location for Azure and OCI.",
+			},
+		},
+	}
+}
+
+func resourceAviatrixControllerBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	backupConfig, err := client.GetCloudnBackupConfig()
+	if err != nil {
+		return diag.Errorf("failed to read controller backup config: %s", err)
+	}
+	if backupConfig.BackupConfiguration != "yes" {
+		return diag.Errorf("controller backups are not enabled, please enable 'backup_configuration' in aviatrix_controller_config first")
+	}
+
+	log.Printf("[INFO] Creating Aviatrix controller backup")
+
+	fileName, err := client.CreateControllerBackup(ctx)
+	if err != nil {
+		return diag.Errorf("failed to create Aviatrix controller backup: %s", err)
+	}
+
+	d.SetId(fileName)
+	d.Set("file_name", fileName)
+	d.Set("cloud_type", backupConfig.BackupCloudType)
+	d.Set("account_name", backupConfig.BackupAccountName)
+	d.Set("bucket_name", backupConfig.BackupBucketName)
+	d.Set("storage_name", backupConfig.BackupStorageName)
+	d.Set("container_name", backupConfig.BackupContainerName)
+	d.Set("region", backupConfig.BackupRegion)
+	return resourceAviatrixControllerBackupRead(ctx, d, meta)
+}
+
+func resourceAviatrixControllerBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	backups, err := client.ListControllerBackups(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list Aviatrix controller backups: %s", err)
+	}
+
+	for _, backup := range backups {
+		if backup.FileName == d.Id() {
+			d.Set("created_at", backup.CreatedAt)
+			d.Set("size", backup.Size)
+			return nil
+		}
+	}
+
+	// The backup may have been rotated out of the backup location. It is kept in the state, so
+	// that a new backup is only created when the triggers change.
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Couldn't find the controller backup",
+		Detail:   fmt.Sprintf("Controller backup %s is no longer in the backup location.", d.Id()),
+	}}
+}
+
+func resourceAviatrixControllerBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The backup is kept in the backup location, only the resource is removed from the state
+	return nil
+}
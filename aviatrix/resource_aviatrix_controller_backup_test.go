@@ -0,0 +1,97 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixControllerBackup_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+
+	skipAcc := os.Getenv("SKIP_CONTROLLER_BACKUP")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Controller Backup test as SKIP_CONTROLLER_BACKUP is set")
+	}
+	msgCommon := ". Set SKIP_CONTROLLER_BACKUP to yes to skip Controller Backup tests"
+	resourceName := "aviatrix_controller_backup.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preAccountCheck(t, msgCommon)
+			if os.Getenv("AWS_BACKUP_BUCKET") == "" {
+				t.Fatal("Environment variable AWS_BACKUP_BUCKET is not set" + msgCommon)
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControllerBackupBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControllerBackupExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "file_name"),
+					resource.TestCheckResourceAttr(resourceName, "bucket_name", os.Getenv("AWS_BACKUP_BUCKET")),
+				),
+			},
+		},
+	})
+}
+
+func testAccControllerBackupBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test_account" {
+	account_name       = "tfa-%s"
+	cloud_type         = 1
+	aws_account_number = "%s"
+	aws_iam            = false
+	aws_access_key     = "%s"
+	aws_secret_key     = "%s"
+}
+resource "aviatrix_controller_config" "test_controller_config" {
+	backup_configuration = true
+	backup_cloud_type    = 1
+	backup_account_name  = aviatrix_account.test_account.account_name
+	backup_bucket_name   = "%s"
+}
+resource "aviatrix_controller_backup" "test" {
+	triggers = {
+		run = "%[1]s"
+	}
+
+	depends_on = [aviatrix_controller_config.test_controller_config]
+}
+	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_BACKUP_BUCKET"))
+}
+
+func testAccCheckControllerBackupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("controller backup Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no controller backup ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		backups, err := client.ListControllerBackups(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, backup := range backups {
+			if backup.FileName == rs.Primary.ID {
+				return nil
+			}
+		}
+		return fmt.Errorf("controller backup %s not found in the backup location", rs.Primary.ID)
+	}
+}
@@ -1,12 +1,15 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -17,14 +20,16 @@ var validAwsGuardDutyScanningIntervals = []int{5, 10, 15, 30, 60}
 
 func resourceAviatrixControllerConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixControllerConfigCreate,
-		Read:   resourceAviatrixControllerConfigRead,
-		Update: resourceAviatrixControllerConfigUpdate,
-		Delete: resourceAviatrixControllerConfigDelete,
+		CreateWithoutTimeout: resourceAviatrixControllerConfigCreate,
+		ReadWithoutTimeout:   resourceAviatrixControllerConfigRead,
+		UpdateWithoutTimeout: resourceAviatrixControllerConfigUpdate,
+		DeleteWithoutTimeout: resourceAviatrixControllerConfigDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceAviatrixControllerConfigCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"http_access": {
 				Type:        schema.TypeBool,
@@ -52,6 +57,23 @@ func resourceAviatrixControllerConfig() *schema.Resource {
 					"case gateway upgrades should be handled in each gateway resource individually using the " +
 					"software_version and image_version attributes.",
 			},
+			"upgrade_preflight_overrides": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(goaviatrix.ControllerUpgradeCheckNames, false),
+				},
+				Description: "Names of the upgrade pre-flight checks that may fail without blocking an upgrade to " +
+					"target_version. Valid values: 'upgrade_path', 'gateway_image_compatibility', 'disk_space' and 'backup'.",
+			},
+			"upgrade_preflight_max_backup_age_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(goaviatrix.DefaultControllerUpgradeMaxBackupAge / time.Hour),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum age in hours of the last controller backup for the 'backup' upgrade pre-flight check.",
+			},
 			"backup_configuration": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -166,6 +188,34 @@ func resourceAviatrixControllerConfig() *schema.Resource {
 				RequiredWith:  []string{"server_public_certificate_file", "ca_certificate_file"},
 				ConflictsWith: []string{"server_private_key_file_path"},
 			},
+			"certificate_expiry_warning_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultCertificateExpiryWarningDays,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of days before the expiry of the imported HTTPS certificates to start warning about them.",
+			},
+			"server_certificate_subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Subject of the imported server certificate.",
+			},
+			"server_certificate_not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiry time of the imported server certificate in RFC 3339 format.",
+			},
+			"server_certificate_sans": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "DNS names and IP addresses of the subject alternative names of the imported server certificate.",
+			},
+			"ca_certificate_not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiry time of the imported CA certificate in RFC 3339 format.",
+			},
 			"aws_guard_duty_scanning_interval": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -177,7 +227,7 @@ func resourceAviatrixControllerConfig() *schema.Resource {
 	}
 }
 
-func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixControllerConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var err error
 
 	client := meta.(*goaviatrix.Client)
@@ -203,7 +253,7 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interfa
 		}
 	}
 	if err != nil {
-		return fmt.Errorf("failed to configure controller http access: %s", err)
+		return diag.Errorf("failed to configure controller http access: %s", err)
 	}
 
 	fqdnExceptionRule := d.Get("fqdn_exception_rule").(bool)
@@ -223,20 +273,7 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interfa
 		}
 	}
 	if err != nil {
-		return fmt.Errorf("failed to configure controller exception rule: %s", err)
-	}
-
-	version := &goaviatrix.Version{
-		Version: d.Get("target_version").(string),
-	}
-	if version.Version != "" {
-		manageGatewayUpgrades := d.Get("manage_gateway_upgrades").(bool)
-		err = client.AsyncUpgrade(version, manageGatewayUpgrades)
-		if err != nil {
-			return fmt.Errorf("failed to upgrade Aviatrix Controller: %s", err)
-		}
-		newCurrent, _, _ := client.GetCurrentVersion()
-		log.Printf("Upgrade complete (now %s)", newCurrent)
+		return diag.Errorf("failed to configure controller exception rule: %s", err)
 	}
 
 	backupConfiguration := d.Get("backup_configuration").(bool)
@@ -251,7 +288,7 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interfa
 	if backupConfiguration {
 		err = validateBackupConfig(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		cloudnBackupConfiguration := &goaviatrix.CloudnBackupConfiguration{
@@ -268,21 +305,31 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interfa
 
 		err = client.EnableCloudnBackupConfig(cloudnBackupConfiguration)
 		if err != nil {
-			return fmt.Errorf("failed to enable backup configuration: %s", err)
+			return diag.Errorf("failed to enable backup configuration: %s", err)
 		}
 	} else {
 		if backupCloudType != 0 || backupAccountName != "" || backupBucketName != "" || backupStorageName != "" ||
 			backupContainerName != "" || backupRegion != "" || multipleBackups {
-			return fmt.Errorf("'backup_cloud_type', 'backup_account_name', 'backup_bucket_name'," +
+			return diag.Errorf("'backup_cloud_type', 'backup_account_name', 'backup_bucket_name'," +
 				" 'backup_storage_name', 'backup_container_name' and 'backup_region' should all be empty," +
 				" 'multiple_backups' should be empty or false for not enabling backup configuration")
 		}
 	}
 
+	version := &goaviatrix.Version{
+		Version: d.Get("target_version").(string),
+	}
+	if version.Version != "" {
+		err = upgradeController(client, d, version)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	enableVpcDnsServer := d.Get("enable_vpc_dns_server").(bool)
 	err = client.SetControllerVpcDnsServer(enableVpcDnsServer)
 	if err != nil {
-		return fmt.Errorf("could not toggle controller vpc dns server: %v", err)
+		return diag.Errorf("could not toggle controller vpc dns server: %v", err)
 	}
 
 	if _, useFilePath := d.GetOk("ca_certificate_file_path"); useFilePath {
@@ -293,7 +340,7 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interfa
 		}
 		err = client.ImportNewHTTPSCerts(certConfig)
 		if err != nil {
-			return fmt.Errorf("could not import HTTPS certs: %v", err)
+			return diag.Errorf("could not import HTTPS certs: %v", err)
 		}
 	} else if _, useFileContent := d.GetOk("ca_certificate_file"); useFileContent {
 		certConfig := &goaviatrix.HTTPSCertConfig{
@@ -303,27 +350,27 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interfa
 		}
 		err = client.ImportNewHTTPSCerts(certConfig)
 		if err != nil {
-			return fmt.Errorf("could not import HTTPS certs: %v", err)
+			return diag.Errorf("could not import HTTPS certs: %v", err)
 		}
 	}
 
 	scanningInterval := d.Get("aws_guard_duty_scanning_interval")
 	err = client.UpdateAwsGuardDutyPollInterval(scanningInterval.(int))
 	if err != nil {
-		return fmt.Errorf("could not update scanning interval: %v", err)
+		return diag.Errorf("could not update scanning interval: %v", err)
 	}
 
 	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
-	return resourceAviatrixControllerConfigRead(d, meta)
+	return resourceAviatrixControllerConfigRead(ctx, d, meta)
 }
 
-func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixControllerConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 
 	log.Printf("[INFO] Getting controller %s configuration", d.Id())
 	result, err := client.GetHttpAccessEnabled()
 	if err != nil {
-		return fmt.Errorf("could not read Aviatrix Controller http access configuration: %s", err)
+		return diag.Errorf("could not read Aviatrix Controller http access configuration: %s", err)
 	}
 
 	if result[1:5] == "True" {
@@ -334,7 +381,7 @@ func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta interface
 
 	res, err := client.GetExceptionRuleStatus()
 	if err != nil {
-		return fmt.Errorf("could not read Aviatrix Controller Exception Rule Status: %s", err)
+		return diag.Errorf("could not read Aviatrix Controller Exception Rule Status: %s", err)
 	}
 	if res {
 		d.Set("fqdn_exception_rule", true)
@@ -349,7 +396,7 @@ func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta interface
 		versionInfo, err = client.GetVersionInfo()
 		if err != nil {
 			if try == maxTries {
-				return fmt.Errorf("unable to read Controller version information: %s", err)
+				return diag.Errorf("unable to read Controller version information: %s", err)
 			}
 			time.Sleep(backoff)
 			// Double the backoff time after each failed try
@@ -375,7 +422,7 @@ func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta interface
 
 	cloudnBackupConfig, err := client.GetCloudnBackupConfig()
 	if err != nil {
-		return fmt.Errorf("unable to read current controller cloudn backup config: %s", err)
+		return diag.Errorf("unable to read current controller cloudn backup config: %s", err)
 	}
 	if cloudnBackupConfig != nil && cloudnBackupConfig.BackupConfiguration == "yes" {
 		d.Set("backup_configuration", true)
@@ -398,14 +445,14 @@ func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta interface
 
 	vpcDnsServerEnabled, err := client.GetControllerVpcDnsServerStatus()
 	if err != nil {
-		return fmt.Errorf("could not get controller vpc dns server status: %v", err)
+		return diag.Errorf("could not get controller vpc dns server status: %v", err)
 	}
 
 	d.Set("enable_vpc_dns_server", vpcDnsServerEnabled)
 
 	httpsCertsImported, err := client.GetHTTPSCertsStatus()
 	if err != nil {
-		return fmt.Errorf("could not get HTTPS Certificate status: %v", err)
+		return diag.Errorf("could not get HTTPS Certificate status: %v", err)
 	}
 	if !httpsCertsImported {
 		d.Set("ca_certificate_file_path", "")
@@ -415,18 +462,22 @@ func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta interface
 		d.Set("server_public_certificate_file", "")
 		d.Set("server_private_key_file", "")
 	}
+	diags := setControllerHTTPSCertificateInfo(d)
+	if diags.HasError() {
+		return diags
+	}
 
 	guardDuty, err := client.GetAwsGuardDuty()
 	if err != nil {
-		return fmt.Errorf("could not get aws guard duty scanning interval: %v", err)
+		return diag.Errorf("could not get aws guard duty scanning interval: %v", err)
 	}
 	d.Set("aws_guard_duty_scanning_interval", guardDuty.ScanningInterval)
 
 	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
-	return nil
+	return diags
 }
 
-func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixControllerConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 
 	log.Printf("[INFO] Updating Controller configuration: %#v", d)
@@ -439,14 +490,14 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 			time.Sleep(10 * time.Second)
 			if err != nil {
 				log.Printf("[ERROR] Failed to enable http access on controller %s", d.Id())
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
 			err := client.DisableHttpAccess()
 			time.Sleep(10 * time.Second)
 			if err != nil {
 				log.Printf("[ERROR] Failed to disable http access on controller %s", d.Id())
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -457,54 +508,13 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 			err := client.EnableExceptionRule()
 			if err != nil {
 				log.Printf("[ERROR] Failed to enable exception rule on controller %s", d.Id())
-				return err
+				return diag.FromErr(err)
 			}
 		} else {
 			err := client.DisableExceptionRule()
 			if err != nil {
 				log.Printf("[ERROR] Failed to disable exception rule on controller %s", d.Id())
-				return err
-			}
-		}
-	}
-
-	if d.HasChange("target_version") {
-		curVersion := d.Get("current_version").(string)
-		cur := strings.Split(curVersion, ".")
-		latestVersion, _ := client.GetLatestVersion()
-		latest := strings.Split(latestVersion, ".")
-		version := &goaviatrix.Version{
-			Version: d.Get("target_version").(string),
-		}
-		if version.Version != "" {
-			manageGatewayUpgrades := d.Get("manage_gateway_upgrades").(bool)
-			targetVersion := d.Get("target_version").(string)
-			if targetVersion == "latest" {
-				if latestVersion != "" {
-					if len(cur) != len(latest) {
-						err := client.AsyncUpgrade(version, manageGatewayUpgrades)
-						if err != nil {
-							return fmt.Errorf("failed to upgrade Aviatrix Controller: %s", err)
-						}
-					} else {
-						for i := range cur {
-							if cur[i] != latest[i] {
-								err := client.AsyncUpgrade(version, manageGatewayUpgrades)
-								if err != nil {
-									return fmt.Errorf("failed to upgrade Aviatrix Controller: %s", err)
-								}
-								break
-							}
-						}
-					}
-				} else {
-					log.Printf("[INFO] Controller is already on latest version")
-				}
-			} else {
-				err := client.AsyncUpgrade(version, manageGatewayUpgrades)
-				if err != nil {
-					return fmt.Errorf("failed to upgrade Aviatrix Controller: %s", err)
-				}
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -522,7 +532,7 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 		if backupConfiguration {
 			err := validateBackupConfig(d)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			cloudnBackupConfiguration := &goaviatrix.CloudnBackupConfiguration{
@@ -539,19 +549,19 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 
 			err = client.EnableCloudnBackupConfig(cloudnBackupConfiguration)
 			if err != nil {
-				return fmt.Errorf("failed to enable backup configuration: %s", err)
+				return diag.Errorf("failed to enable backup configuration: %s", err)
 			}
 		} else {
 			if backupCloudType != 0 || backupAccountName != "" || backupBucketName != "" || backupStorageName != "" ||
 				backupContainerName != "" || backupRegion != "" || multipleBackups {
-				return fmt.Errorf("'backup_cloud_type', 'backup_account_name', 'backup_bucket_name'," +
+				return diag.Errorf("'backup_cloud_type', 'backup_account_name', 'backup_bucket_name'," +
 					" 'backup_storage_name', 'backup_container_name' and 'backup_region' should all be empty," +
 					" 'multiple_backups' should be empty or false for not enabling backup configuration")
 			}
 
 			err := client.DisableCloudnBackupConfig()
 			if err != nil {
-				return fmt.Errorf("failed to disable backup configuration: %s", err)
+				return diag.Errorf("failed to disable backup configuration: %s", err)
 			}
 		}
 	} else {
@@ -563,12 +573,12 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 			if backupConfiguration {
 				err := validateBackupConfig(d)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 
 				err = client.DisableCloudnBackupConfig()
 				if err != nil {
-					return fmt.Errorf("failed to disable backup configuration: %s", err)
+					return diag.Errorf("failed to disable backup configuration: %s", err)
 				}
 
 				cloudnBackupConfiguration := &goaviatrix.CloudnBackupConfiguration{
@@ -585,12 +595,12 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 
 				err = client.EnableCloudnBackupConfig(cloudnBackupConfiguration)
 				if err != nil {
-					return fmt.Errorf("failed to enable backup configuration: %s", err)
+					return diag.Errorf("failed to enable backup configuration: %s", err)
 				}
 			} else {
 				if backupCloudType != 0 || backupAccountName != "" || backupBucketName != "" || backupStorageName != "" ||
 					backupContainerName != "" || backupRegion != "" || multipleBackups {
-					return fmt.Errorf("'backup_cloud_type', 'backup_account_name', 'backup_bucket_name'," +
+					return diag.Errorf("'backup_cloud_type', 'backup_account_name', 'backup_bucket_name'," +
 						" 'backup_storage_name', 'backup_container_name' and 'backup_region' should all be empty," +
 						" 'multiple_backups' should be empty or false for not enabling backup configuration")
 				}
@@ -598,11 +608,23 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.HasChange("target_version") {
+		version := &goaviatrix.Version{
+			Version: d.Get("target_version").(string),
+		}
+		if version.Version != "" {
+			err := upgradeController(client, d, version)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	if d.HasChange("enable_vpc_dns_server") {
 		enableVpcDnsServer := d.Get("enable_vpc_dns_server").(bool)
 		err := client.SetControllerVpcDnsServer(enableVpcDnsServer)
 		if err != nil {
-			return fmt.Errorf("could not toggle controller vpc dns server: %v", err)
+			return diag.Errorf("could not toggle controller vpc dns server: %v", err)
 		}
 	}
 
@@ -617,7 +639,7 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 
 			err := client.ImportNewHTTPSCerts(certConfig)
 			if err != nil {
-				return fmt.Errorf("could not import new HTTPS certs: %v", err)
+				return diag.Errorf("could not import new HTTPS certs: %v", err)
 			}
 		} else if _, useFileContent := d.GetOk("ca_certificate_file"); useFileContent {
 			certConfig := &goaviatrix.HTTPSCertConfig{
@@ -628,7 +650,7 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 
 			err := client.ImportNewHTTPSCerts(certConfig)
 			if err != nil {
-				return fmt.Errorf("could not import new HTTPS certs: %v", err)
+				return diag.Errorf("could not import new HTTPS certs: %v", err)
 			}
 		}
 	}
@@ -637,15 +659,15 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 		scanningInterval := d.Get("aws_guard_duty_scanning_interval").(int)
 		err := client.UpdateAwsGuardDutyPollInterval(scanningInterval)
 		if err != nil {
-			return fmt.Errorf("could not update scanning interval: %v", err)
+			return diag.Errorf("could not update scanning interval: %v", err)
 		}
 	}
 
 	d.Partial(false)
-	return resourceAviatrixControllerConfigRead(d, meta)
+	return resourceAviatrixControllerConfigRead(ctx, d, meta)
 }
 
-func resourceAviatrixControllerConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixControllerConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 	d.Set("http_access", false)
 	curStatusHttp, _ := client.GetHttpAccessEnabled()
@@ -654,7 +676,7 @@ func resourceAviatrixControllerConfigDelete(d *schema.ResourceData, meta interfa
 		time.Sleep(10 * time.Second)
 		if err != nil {
 			log.Printf("[ERROR] Failed to disable http access on controller %s", d.Id())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -664,7 +686,7 @@ func resourceAviatrixControllerConfigDelete(d *schema.ResourceData, meta interfa
 		err := client.EnableExceptionRule()
 		if err != nil {
 			log.Printf("[ERROR] Failed to enable exception rule on controller %s", d.Id())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -674,28 +696,191 @@ func resourceAviatrixControllerConfigDelete(d *schema.ResourceData, meta interfa
 		err := client.DisableCloudnBackupConfig()
 		if err != nil {
 			log.Printf("[ERROR] Failed to disable cloudn backup config on controller %s", d.Id())
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	err := client.SetControllerVpcDnsServer(false)
 	if err != nil {
-		return fmt.Errorf("could not disable controller vpc dns server: %v", err)
+		return diag.Errorf("could not disable controller vpc dns server: %v", err)
 	}
 
 	err = client.DisableImportedHTTPSCerts()
 	if err != nil {
-		return fmt.Errorf("could not disable imported certs: %v", err)
+		return diag.Errorf("could not disable imported certs: %v", err)
 	}
 
 	err = client.UpdateAwsGuardDutyPollInterval(defaultAwsGuardDutyScanningInterval)
 	if err != nil {
-		return fmt.Errorf("could not update scanning interval: %v", err)
+		return diag.Errorf("could not update scanning interval: %v", err)
 	}
 
 	return nil
 }
 
+// upgradeController upgrades the controller to version unless it is already on it. The upgrade pre-flight
+// checks run first, so the backup configuration must be applied before the upgrade.
+func upgradeController(client *goaviatrix.Client, d *schema.ResourceData, version *goaviatrix.Version) error {
+	onVersion, err := client.ControllerOnVersion(version.Version)
+	if err != nil {
+		return fmt.Errorf("failed to upgrade Aviatrix Controller: %s", err)
+	}
+	if onVersion {
+		log.Printf("[INFO] Controller is already on version %s", version.Version)
+		return nil
+	}
+
+	err = checkControllerUpgradePreflight(client, d, version.Version)
+	if err != nil {
+		return err
+	}
+	manageGatewayUpgrades := d.Get("manage_gateway_upgrades").(bool)
+	err = client.AsyncUpgrade(version, manageGatewayUpgrades)
+	if err != nil {
+		return fmt.Errorf("failed to upgrade Aviatrix Controller: %s", err)
+	}
+	newCurrent, _, _ := client.GetCurrentVersion()
+	log.Printf("Upgrade complete (now %s)", newCurrent)
+	return nil
+}
+
+// checkControllerUpgradePreflight refuses an upgrade to targetVersion unless all the upgrade pre-flight
+// checks pass or the failed ones are overridden in upgrade_preflight_overrides.
+func checkControllerUpgradePreflight(client *goaviatrix.Client, d *schema.ResourceData, targetVersion string) error {
+	overrides := getStringSet(d, "upgrade_preflight_overrides")
+	preflight, err := client.RunControllerUpgradePreflight(context.Background(), goaviatrix.ControllerUpgradePreflightOptions{
+		TargetVersion: targetVersion,
+		MaxBackupAge:  time.Duration(d.Get("upgrade_preflight_max_backup_age_hours").(int)) * time.Hour,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to run controller upgrade pre-flight checks: %s", err)
+	}
+
+	for _, check := range preflight.Checks {
+		if !check.Passed && goaviatrix.Contains(overrides, check.Name) {
+			log.Printf("[WARN] Ignoring failed controller upgrade pre-flight check %s: %s", check.Name, check.Message)
+		}
+	}
+	failed := preflight.FailedChecks(overrides)
+	if len(failed) == 0 {
+		return nil
+	}
+
+	var messages []string
+	for _, check := range failed {
+		messages = append(messages, fmt.Sprintf("%s: %s", check.Name, check.Message))
+	}
+	return fmt.Errorf("refusing to upgrade Aviatrix Controller from %s to %s, upgrade pre-flight checks failed:\n%s\n"+
+		"Fix the failed checks or add them to 'upgrade_preflight_overrides' to upgrade anyway",
+		preflight.CurrentVersion, preflight.TargetVersion, strings.Join(messages, "\n"))
+}
+
+func resourceAviatrixControllerConfigCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	certKeys := []string{"ca_certificate_file_path", "server_public_certificate_file_path", "server_private_key_file_path",
+		"ca_certificate_file", "server_public_certificate_file", "server_private_key_file"}
+	if !d.HasChanges(certKeys...) {
+		return nil
+	}
+	for _, k := range certKeys {
+		if !d.NewValueKnown(k) {
+			for _, computed := range []string{"server_certificate_subject", "server_certificate_not_after", "server_certificate_sans", "ca_certificate_not_after"} {
+				if err := d.SetNewComputed(computed); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	caCert, serverCert, serverKey, err := readControllerHTTPSCertificates(d.Get)
+	if err != nil {
+		// The files may only be created during the apply
+		log.Printf("[WARN] Skipping the validation of the controller HTTPS certificates: %v", err)
+		return nil
+	}
+	if serverCert == "" {
+		return nil
+	}
+
+	if err := goaviatrix.ValidateCertificateKeyPair(serverCert, serverKey); err != nil {
+		return fmt.Errorf("invalid controller HTTPS server certificate and private key: %v", err)
+	}
+	if err := goaviatrix.ValidateCertificateChain(serverCert, caCert, time.Now()); err != nil {
+		return fmt.Errorf("invalid controller HTTPS certificates: %v", err)
+	}
+
+	serverCertInfo, err := goaviatrix.GetCertificateInfo(serverCert)
+	if err != nil {
+		return err
+	}
+	caCertInfo, err := goaviatrix.GetCertificateInfo(caCert)
+	if err != nil {
+		return err
+	}
+	d.SetNew("server_certificate_subject", serverCertInfo.Subject)
+	d.SetNew("server_certificate_not_after", serverCertInfo.NotAfter.UTC().Format(time.RFC3339))
+	d.SetNew("server_certificate_sans", serverCertInfo.SANs)
+	d.SetNew("ca_certificate_not_after", caCertInfo.NotAfter.UTC().Format(time.RFC3339))
+	return nil
+}
+
+// readControllerHTTPSCertificates returns the PEM contents of the controller HTTPS certificates, either
+// given as file contents or as file paths. Empty strings are returned if no certificates are given.
+func readControllerHTTPSCertificates(get func(string) interface{}) (caCert, serverCert, serverKey string, err error) {
+	if get("ca_certificate_file").(string) != "" {
+		return get("ca_certificate_file").(string), get("server_public_certificate_file").(string), get("server_private_key_file").(string), nil
+	}
+	if get("ca_certificate_file_path").(string) == "" {
+		return "", "", "", nil
+	}
+
+	var contents []string
+	for _, k := range []string{"ca_certificate_file_path", "server_public_certificate_file_path", "server_private_key_file_path"} {
+		b, err := os.ReadFile(get(k).(string))
+		if err != nil {
+			return "", "", "", fmt.Errorf("could not read %s: %v", k, err)
+		}
+		contents = append(contents, string(b))
+	}
+	return contents[0], contents[1], contents[2], nil
+}
+
+// setControllerHTTPSCertificateInfo sets the computed attributes of the imported HTTPS certificates and
+// warns when they expire soon.
+func setControllerHTTPSCertificateInfo(d *schema.ResourceData) diag.Diagnostics {
+	caCert, serverCert, _, err := readControllerHTTPSCertificates(d.Get)
+	if err != nil {
+		log.Printf("[WARN] Could not read the controller HTTPS certificates: %v", err)
+		return nil
+	}
+	if serverCert == "" {
+		d.Set("server_certificate_subject", "")
+		d.Set("server_certificate_not_after", "")
+		d.Set("server_certificate_sans", nil)
+		d.Set("ca_certificate_not_after", "")
+		return nil
+	}
+
+	serverCertInfo, err := goaviatrix.GetCertificateInfo(serverCert)
+	if err != nil {
+		return diag.Errorf("could not parse controller HTTPS server certificate: %v", err)
+	}
+	caCertInfo, err := goaviatrix.GetCertificateInfo(caCert)
+	if err != nil {
+		return diag.Errorf("could not parse controller HTTPS CA certificate: %v", err)
+	}
+	d.Set("server_certificate_subject", serverCertInfo.Subject)
+	d.Set("server_certificate_not_after", serverCertInfo.NotAfter.UTC().Format(time.RFC3339))
+	if err := d.Set("server_certificate_sans", serverCertInfo.SANs); err != nil {
+		return diag.Errorf("failed to set server_certificate_sans: %v", err)
+	}
+	d.Set("ca_certificate_not_after", caCertInfo.NotAfter.UTC().Format(time.RFC3339))
+
+	warningDays := d.Get("certificate_expiry_warning_days").(int)
+	diags := certificateExpiryWarning("Controller HTTPS server certificate", serverCertInfo, warningDays)
+	return append(diags, certificateExpiryWarning("Controller HTTPS CA certificate", caCertInfo, warningDays)...)
+}
+
 func validateBackupConfig(d *schema.ResourceData) error {
 	backupCloudType := d.Get("backup_cloud_type").(int)
 	backupAccountName := d.Get("backup_account_name").(string)
@@ -0,0 +1,178 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixControllerRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixControllerRestoreCreate,
+		ReadWithoutTimeout:   resourceAviatrixControllerRestoreRead,
+		DeleteWithoutTimeout: resourceAviatrixControllerRestoreDelete,
+
+		CustomizeDiff: resourceAviatrixControllerRestoreCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"controller_ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description: "IP address of the controller to restore. Must match the controller of the provider, " +
+					"as a guard against restoring the wrong controller.",
+			},
+			"cloud_type": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{goaviatrix.AWS, goaviatrix.AWSGov, goaviatrix.Azure, goaviatrix.GCP, goaviatrix.OCI}),
+				Description:  "Cloud type of the backup location.",
+			},
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Access account with access to the backup location.",
+			},
+			"bucket_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Bucket of the backup location. Required for AWS, AWSGov, GCP and OCI.",
+			},
+			"storage_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Storage account of the backup location. Required for Azure.",
+			},
+			"container_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Container of the backup location. Required for Azure.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Region of the backup location. Required for Azure and OCI.",
+			},
+			"file_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "File name of the backup to restore.",
+			},
+			"allow_non_fresh_controller": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+				Description: "If false, the restore is refused when the controller already has gateways. " +
+					"Set to true to restore a controller that isn't freshly launched.",
+			},
+		},
+	}
+}
+
+func resourceAviatrixControllerRestoreCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	cloudType := d.Get("cloud_type").(int)
+	bucketName := d.Get("bucket_name").(string)
+	storageName := d.Get("storage_name").(string)
+	containerName := d.Get("container_name").(string)
+	region := d.Get("region").(string)
+
+	switch cloudType {
+	case goaviatrix.AWS, goaviatrix.AWSGov, goaviatrix.GCP:
+		if bucketName == "" {
+			return fmt.Errorf("please specify 'bucket_name' to restore a backup from AWS, AWSGov and GCP")
+		}
+		if storageName != "" || containerName != "" || region != "" {
+			return fmt.Errorf("'storage_name', 'container_name' and 'region' should be empty for AWS, AWSGov and GCP")
+		}
+	case goaviatrix.Azure:
+		if storageName == "" || containerName == "" || region == "" {
+			return fmt.Errorf("please specify 'storage_name', 'container_name' and 'region' to restore a backup from Azure")
+		}
+		if bucketName != "" {
+			return fmt.Errorf("'bucket_name' should be empty for Azure")
+		}
+	case goaviatrix.OCI:
+		if bucketName == "" || region == "" {
+			return fmt.Errorf("please specify 'bucket_name' and 'region' to restore a backup from OCI")
+		}
+		if storageName != "" || containerName != "" {
+			return fmt.Errorf("'storage_name' and 'container_name' should be empty for OCI")
+		}
+	}
+	return nil
+}
+
+func resourceAviatrixControllerRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	controllerIP := d.Get("controller_ip").(string)
+	if controllerIP != client.ControllerIP {
+		return diag.Errorf("refusing to restore controller %s, the provider is configured for controller %s", controllerIP, client.ControllerIP)
+	}
+
+	if !d.Get("allow_non_fresh_controller").(bool) {
+		gatewayCount, err := client.GetGatewayCount(ctx)
+		if err != nil {
+			return diag.Errorf("could not get gateway count: %s", err)
+		}
+		if gatewayCount > 0 {
+			return diag.Errorf("refusing to restore controller %s since it has %d gateways and doesn't look freshly launched. "+
+				"Set 'allow_non_fresh_controller' to true to restore it anyway", controllerIP, gatewayCount)
+		}
+	}
+
+	restore := &goaviatrix.ControllerRestore{
+		CloudType:     d.Get("cloud_type").(int),
+		AccountName:   d.Get("account_name").(string),
+		BucketName:    d.Get("bucket_name").(string),
+		StorageName:   d.Get("storage_name").(string),
+		ContainerName: d.Get("container_name").(string),
+		Region:        d.Get("region").(string),
+		FileName:      d.Get("file_name").(string),
+	}
+
+	log.Printf("[INFO] Restoring Aviatrix controller %s from backup %s", controllerIP, restore.FileName)
+
+	if err := client.RestoreControllerBackup(ctx, restore); err != nil {
+		return diag.Errorf("failed to restore Aviatrix controller from backup %s: %s", restore.FileName, err)
+	}
+	d.SetId(fmt.Sprintf("%s~%s", controllerIP, restore.FileName))
+
+	// The restore ends the session of the provider, and the credentials of the provider may no
+	// longer be valid if they differ from the ones in the backup
+	if err := client.Login(); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Couldn't log in to the restored controller",
+			Detail: fmt.Sprintf("The controller was restored from backup %s, but logging in again failed: %v. "+
+				"Other resources may fail until the provider is configured with the credentials of the backup.", restore.FileName, err),
+		}}
+	}
+	return nil
+}
+
+func resourceAviatrixControllerRestoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A restore is a one-off operation, there is nothing to read back from the controller
+	return nil
+}
+
+func resourceAviatrixControllerRestoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A restore can't be undone, only the resource is removed from the state
+	return nil
+}
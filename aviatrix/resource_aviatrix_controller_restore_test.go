@@ -0,0 +1,48 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Restoring the controller under test would replace its configuration, so only the checks made
+// before the restore are tested
+func TestAccAviatrixControllerRestore_guards(t *testing.T) {
+	skipAcc := os.Getenv("SKIP_CONTROLLER_RESTORE")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Controller Restore test as SKIP_CONTROLLER_RESTORE is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccControllerRestoreConfig(`bucket_name = "backup-bucket"`),
+				ExpectError: regexp.MustCompile("refusing to restore controller 0.0.0.0"),
+			},
+			{
+				Config:      testAccControllerRestoreConfig(""),
+				ExpectError: regexp.MustCompile("please specify 'bucket_name'"),
+			},
+		},
+	})
+}
+
+func testAccControllerRestoreConfig(location string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_controller_restore" "test" {
+	controller_ip = "0.0.0.0"
+	cloud_type    = 1
+	account_name  = "backup-account"
+	file_name     = "CloudN_backup.enc"
+	%s
+}
+	`, location)
+}
@@ -4,39 +4,107 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const defaultCertificateExpiryWarningDays = 30
+
 func resourceAviatrixGatewayCertificateConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAviatrixGatewayCertificateConfigCreate,
 		ReadWithoutTimeout:   resourceAviatrixGatewayCertificateConfigRead,
+		UpdateWithoutTimeout: resourceAviatrixGatewayCertificateConfigUpdate,
 		DeleteWithoutTimeout: resourceAviatrixGatewayCertificateConfigDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceAviatrixGatewayCertificateConfigCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"ca_certificate": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "CA Certificate.",
 			},
 			"ca_private_key": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Sensitive:   true,
 				Description: "CA Private Key.",
 			},
+			"certificate_expiry_warning_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultCertificateExpiryWarningDays,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of days before the expiry of the CA certificate to start warning about it.",
+			},
+			"ca_certificate_subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Subject of the CA certificate.",
+			},
+			"ca_certificate_not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiry time of the CA certificate in RFC 3339 format.",
+			},
+			"ca_certificate_sans": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "DNS names and IP addresses of the subject alternative names of the CA certificate.",
+			},
 		},
 	}
 }
 
+func resourceAviatrixGatewayCertificateConfigCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("ca_certificate", "ca_private_key") {
+		return nil
+	}
+	if !d.NewValueKnown("ca_certificate") || !d.NewValueKnown("ca_private_key") {
+		for _, k := range []string{"ca_certificate_subject", "ca_certificate_not_after", "ca_certificate_sans"} {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	caCertificate := d.Get("ca_certificate").(string)
+	caPrivateKey := d.Get("ca_private_key").(string)
+	// Empty values are allowed after an import, since the certificate and key can't be read from the controller
+	if caCertificate == "" && caPrivateKey == "" {
+		d.SetNew("ca_certificate_subject", "")
+		d.SetNew("ca_certificate_not_after", "")
+		d.SetNew("ca_certificate_sans", []string{})
+		return nil
+	}
+
+	if err := goaviatrix.ValidateCACertificate(caCertificate); err != nil {
+		return fmt.Errorf("invalid 'ca_certificate': %v", err)
+	}
+	if err := goaviatrix.ValidateCertificateKeyPair(caCertificate, caPrivateKey); err != nil {
+		return fmt.Errorf("invalid 'ca_certificate' and 'ca_private_key': %v", err)
+	}
+
+	certInfo, err := goaviatrix.GetCertificateInfo(caCertificate)
+	if err != nil {
+		return err
+	}
+	d.SetNew("ca_certificate_subject", certInfo.Subject)
+	d.SetNew("ca_certificate_not_after", certInfo.NotAfter.UTC().Format(time.RFC3339))
+	d.SetNew("ca_certificate_sans", certInfo.SANs)
+	return nil
+}
+
 func marshalGatewayCertificateConfigInput(d *schema.ResourceData) *goaviatrix.GatewayCertificate {
 	return &goaviatrix.GatewayCertificate{
 		CaCertificate: d.Get("ca_certificate").(string),
@@ -69,9 +137,42 @@ func resourceAviatrixGatewayCertificateConfigRead(ctx context.Context, d *schema
 		d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
 	} else {
 		d.SetId("")
+		return nil
 	}
 
-	return nil
+	caCertificate := d.Get("ca_certificate").(string)
+	if caCertificate == "" {
+		d.Set("ca_certificate_subject", "")
+		d.Set("ca_certificate_not_after", "")
+		d.Set("ca_certificate_sans", nil)
+		return nil
+	}
+	certInfo, err := goaviatrix.GetCertificateInfo(caCertificate)
+	if err != nil {
+		return diag.Errorf("could not parse gateway CA certificate: %v", err)
+	}
+	d.Set("ca_certificate_subject", certInfo.Subject)
+	d.Set("ca_certificate_not_after", certInfo.NotAfter.UTC().Format(time.RFC3339))
+	if err := d.Set("ca_certificate_sans", certInfo.SANs); err != nil {
+		return diag.Errorf("failed to set ca_certificate_sans: %v", err)
+	}
+
+	return certificateExpiryWarning("Gateway CA certificate", certInfo, d.Get("certificate_expiry_warning_days").(int))
+}
+
+func resourceAviatrixGatewayCertificateConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	// The new CA certificate replaces the current one without disabling gateway certificate checking
+	if d.HasChanges("ca_certificate", "ca_private_key") {
+		gwCert := marshalGatewayCertificateConfigInput(d)
+
+		if err := client.ConfigureGatewayCertificate(ctx, gwCert); err != nil {
+			return diag.FromErr(fmt.Errorf("could not rotate gateway certificates: %v", err))
+		}
+	}
+
+	return resourceAviatrixGatewayCertificateConfigRead(ctx, d, meta)
 }
 
 func resourceAviatrixGatewayCertificateConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -83,3 +184,16 @@ func resourceAviatrixGatewayCertificateConfigDelete(ctx context.Context, d *sche
 
 	return nil
 }
+
+// certificateExpiryWarning warns when the certificate is expired or expires within warningDays.
+func certificateExpiryWarning(name string, certInfo *goaviatrix.CertificateInfo, warningDays int) diag.Diagnostics {
+	now := time.Now()
+	if !certInfo.ExpiresWithin(now, time.Duration(warningDays)*24*time.Hour) {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s is expiring", name),
+		Detail:   goaviatrix.FormatCertificateExpiry(name, certInfo, now) + " Please rotate the certificate.",
+	}}
+}
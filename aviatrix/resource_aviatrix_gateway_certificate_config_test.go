@@ -29,13 +29,15 @@ func TestAccAviatrixGatewayCertificateConfig_basic(t *testing.T) {
 				Config: testAccGatewayCertificateConfigBasic(),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckGatewayCertificateConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ca_certificate_subject", "CN=cyrusjavan.com,O=Apple,L=Cupertino,ST=CA,C=US"),
+					resource.TestCheckResourceAttr(resourceName, "ca_certificate_not_after", "2026-02-01T18:45:53Z"),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ca_certificate", "ca_private_key"},
+				ImportStateVerifyIgnore: []string{"ca_certificate", "ca_private_key", "ca_certificate_subject", "ca_certificate_not_after", "ca_certificate_sans"},
 			},
 		},
 	})
@@ -0,0 +1,465 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	gatewayUpgradeStatusUpgraded  = "upgraded"
+	gatewayUpgradeStatusUpToDate  = "up_to_date"
+	gatewayUpgradeStatusFailed    = "failed"
+	gatewayUpgradeStatusUnhealthy = "unhealthy"
+	gatewayUpgradeStatusSkipped   = "skipped"
+
+	gatewayUpgradeHealthCheckInterval = 10 * time.Second
+)
+
+func resourceAviatrixGatewayUpgradePlan() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixGatewayUpgradePlanCreate,
+		ReadWithoutTimeout:   resourceAviatrixGatewayUpgradePlanRead,
+		UpdateWithoutTimeout: resourceAviatrixGatewayUpgradePlanUpdate,
+		DeleteWithoutTimeout: resourceAviatrixGatewayUpgradePlanDelete,
+
+		CustomizeDiff: resourceAviatrixGatewayUpgradePlanCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"gw_names": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				Description: "Names of the gateways to upgrade, in upgrade order. HA gateways are upgraded " +
+					"after their primary gateways and must not be listed.",
+			},
+			"canary_gw_names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				Description: "Names of the gateways in gw_names to upgrade, together with their HA gateways, " +
+					"before all other gateways.",
+			},
+			"software_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description: "Software version to upgrade the gateways to. If not set, the gateways are " +
+					"upgraded to the controller's software version.",
+			},
+			"image_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Image version to upgrade the gateways to. If not set, the image version is not changed.",
+			},
+			"max_parallel": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of gateways to upgrade at the same time.",
+			},
+			"verify_tunnels": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If true, a gateway is only considered healthy when none of its tunnels are down.",
+			},
+			"health_check_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      600,
+				ValidateFunc: validation.IntAtLeast(60),
+				Description:  "Seconds to wait for the gateways of a wave to become healthy after their upgrade.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the last run of the plan: 'completed' or 'failed'.",
+			},
+			"waves": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Waves of the last run of the plan.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gw_names": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Names of the gateways upgraded in the wave.",
+						},
+					},
+				},
+			},
+			"gateway_upgrades": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Result of the last run of the plan for each gateway.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gw_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Gateway name.",
+						},
+						"wave": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Wave of the gateway, starting at 1.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Upgrade status: 'upgraded', 'up_to_date', 'failed', 'unhealthy' or 'skipped'.",
+						},
+						"software_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Software version of the gateway.",
+						},
+						"image_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Image version of the gateway.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Reason of a failed, unhealthy or skipped upgrade.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type gatewayUpgradeResult struct {
+	GwName          string
+	Wave            int
+	Status          string
+	SoftwareVersion string
+	ImageVersion    string
+	Message         string
+}
+
+func resourceAviatrixGatewayUpgradePlanCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	var gwNames []string
+	for _, v := range d.Get("gw_names").([]interface{}) {
+		gwName := v.(string)
+		if goaviatrix.Contains(gwNames, gwName) {
+			return fmt.Errorf("gateway %q is listed more than once in gw_names", gwName)
+		}
+		gwNames = append(gwNames, gwName)
+	}
+	for _, v := range d.Get("canary_gw_names").([]interface{}) {
+		if !goaviatrix.Contains(gwNames, v.(string)) {
+			return fmt.Errorf("canary gateway %q must also be listed in gw_names", v.(string))
+		}
+	}
+
+	// A failed plan runs again on the next apply
+	oldStatus, _ := d.GetChange("status")
+	if d.HasChanges("gw_names", "canary_gw_names", "software_version", "image_version") || oldStatus == "failed" {
+		for _, k := range []string{"status", "waves", "gateway_upgrades"} {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func resourceAviatrixGatewayUpgradePlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwNames := getStringList(d, "gw_names")
+
+	log.Printf("[INFO] Running Aviatrix gateway upgrade plan for gateways: %v", gwNames)
+
+	d.SetId(strings.Join(gwNames, "~"))
+	if err := runGatewayUpgradePlan(ctx, client, d); err != nil {
+		return diag.Errorf("failed to run Aviatrix gateway upgrade plan: %s", err)
+	}
+
+	return resourceAviatrixGatewayUpgradePlanRead(ctx, d, meta)
+}
+
+func resourceAviatrixGatewayUpgradePlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	// Only the versions are refreshed, the rest of the results describe the last run of the plan
+	upgrades := d.Get("gateway_upgrades").([]interface{})
+	for _, v := range upgrades {
+		upgrade := v.(map[string]interface{})
+		gw, err := client.GetGateway(&goaviatrix.Gateway{GwName: upgrade["gw_name"].(string)})
+		if err != nil {
+			if err == goaviatrix.ErrNotFound {
+				continue
+			}
+			return diag.Errorf("failed to read gateway %s: %s", upgrade["gw_name"], err)
+		}
+		upgrade["software_version"] = gw.SoftwareVersion
+		upgrade["image_version"] = gw.ImageVersion
+	}
+	if err := d.Set("gateway_upgrades", upgrades); err != nil {
+		return diag.Errorf("failed to set gateway_upgrades: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAviatrixGatewayUpgradePlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	oldStatus, _ := d.GetChange("status")
+	if d.HasChanges("gw_names", "canary_gw_names", "software_version", "image_version") || oldStatus == "failed" {
+		d.SetId(strings.Join(getStringList(d, "gw_names"), "~"))
+		if err := runGatewayUpgradePlan(ctx, client, d); err != nil {
+			return diag.Errorf("failed to run Aviatrix gateway upgrade plan: %s", err)
+		}
+	}
+
+	return resourceAviatrixGatewayUpgradePlanRead(ctx, d, meta)
+}
+
+func resourceAviatrixGatewayUpgradePlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Gateway upgrades can't be undone, so only the plan is removed from the state
+	return nil
+}
+
+// runGatewayUpgradePlan upgrades the gateways of the plan wave by wave. Before the first wave all
+// gateways must be healthy, and the gateways of each wave must be healthy again before the next
+// wave starts. The plan halts at the first failure, and the results are saved in the state so
+// the report is available either way.
+func runGatewayUpgradePlan(ctx context.Context, client *goaviatrix.Client, d *schema.ResourceData) error {
+	gwNames := getStringList(d, "gw_names")
+	softwareVersion := d.Get("software_version").(string)
+	imageVersion := d.Get("image_version").(string)
+	verifyTunnels := d.Get("verify_tunnels").(bool)
+	healthCheckTimeout := time.Duration(d.Get("health_check_timeout").(int)) * time.Second
+
+	targetSoftwareVersion := softwareVersion
+	if targetSoftwareVersion == "" {
+		versionInfo, err := client.GetVersionInfo()
+		if err != nil {
+			return fmt.Errorf("could not get controller version: %v", err)
+		}
+		targetSoftwareVersion = versionInfo.Current.String(versionInfo.Current.HasBuild)
+	}
+
+	var targets []goaviatrix.GatewayUpgradeTarget
+	gateways := make(map[string]*goaviatrix.Gateway)
+	for _, gwName := range gwNames {
+		gw, err := client.GetGateway(&goaviatrix.Gateway{GwName: gwName})
+		if err != nil {
+			return fmt.Errorf("could not find gateway %s: %v", gwName, err)
+		}
+		if gw.IsHagw == "yes" {
+			return fmt.Errorf("gateway %s is an HA gateway, please list its primary gateway instead", gwName)
+		}
+		gateways[gwName] = gw
+		targets = append(targets, goaviatrix.GatewayUpgradeTarget{GwName: gwName, HaGwName: gw.HaGw.GwName})
+		if gw.HaGw.GwName != "" {
+			gateways[gw.HaGw.GwName] = &goaviatrix.Gateway{
+				GwName:          gw.HaGw.GwName,
+				SoftwareVersion: gw.HaGw.SoftwareVersion,
+				ImageVersion:    gw.HaGw.ImageVersion,
+			}
+		}
+	}
+
+	waves := goaviatrix.PlanGatewayUpgradeWaves(targets, getStringList(d, "canary_gw_names"), d.Get("max_parallel").(int))
+
+	var results []*gatewayUpgradeResult
+	resultsByName := make(map[string]*gatewayUpgradeResult)
+	for i, wave := range waves {
+		for _, gwName := range wave {
+			result := &gatewayUpgradeResult{
+				GwName:          gwName,
+				Wave:            i + 1,
+				Status:          gatewayUpgradeStatusSkipped,
+				SoftwareVersion: gateways[gwName].SoftwareVersion,
+				ImageVersion:    gateways[gwName].ImageVersion,
+				Message:         "plan halted before the wave started",
+			}
+			results = append(results, result)
+			resultsByName[gwName] = result
+		}
+	}
+
+	planErr := func() error {
+		var unhealthy []string
+		for _, result := range results {
+			if err := checkGatewayUpgradeHealth(ctx, client, result, verifyTunnels); err != nil {
+				result.Message = fmt.Sprintf("gateway was unhealthy before the upgrade: %v", err)
+				unhealthy = append(unhealthy, result.GwName)
+			}
+		}
+		if len(unhealthy) != 0 {
+			return fmt.Errorf("gateways %s are unhealthy, no gateway was upgraded", strings.Join(unhealthy, ", "))
+		}
+
+		for i, wave := range waves {
+			log.Printf("[INFO] Upgrading wave %d of %d: %v", i+1, len(waves), wave)
+
+			var tasks []func() error
+			for _, gwName := range wave {
+				result := resultsByName[gwName]
+				needsUpgrade, err := goaviatrix.GatewayNeedsUpgrade(result.SoftwareVersion, result.ImageVersion, targetSoftwareVersion, imageVersion)
+				if err != nil {
+					return fmt.Errorf("could not compare software version of gateway %s: %v", gwName, err)
+				}
+				if !needsUpgrade {
+					result.Status = gatewayUpgradeStatusUpToDate
+					result.Message = ""
+					continue
+				}
+				tasks = append(tasks, func() error {
+					err := client.UpgradeGateway(&goaviatrix.Gateway{
+						GwName:          result.GwName,
+						SoftwareVersion: softwareVersion,
+						ImageVersion:    imageVersion,
+					})
+					if err != nil {
+						result.Status = gatewayUpgradeStatusFailed
+						result.Message = err.Error()
+						return fmt.Errorf("could not upgrade gateway %s: %v", result.GwName, err)
+					}
+					result.Status = gatewayUpgradeStatusUpgraded
+					result.Message = ""
+					return nil
+				})
+			}
+			if err := runConcurrently(len(tasks), tasks); err != nil {
+				return fmt.Errorf("wave %d failed: %v", i+1, err)
+			}
+
+			for _, gwName := range wave {
+				result := resultsByName[gwName]
+				if result.Status != gatewayUpgradeStatusUpgraded {
+					continue
+				}
+				err := waitForGatewayHealth(ctx, client, gwName, verifyTunnels, healthCheckTimeout)
+				if err == nil {
+					err = checkGatewayUpgradeHealth(ctx, client, result, verifyTunnels)
+				}
+				if err != nil {
+					result.Status = gatewayUpgradeStatusUnhealthy
+					result.Message = err.Error()
+					return fmt.Errorf("gateway %s of wave %d is unhealthy after the upgrade: %v", gwName, i+1, err)
+				}
+			}
+		}
+		return nil
+	}()
+
+	status := "completed"
+	if planErr != nil {
+		status = "failed"
+	}
+	d.Set("status", status)
+
+	var wavesList []map[string]interface{}
+	for _, wave := range waves {
+		wavesList = append(wavesList, map[string]interface{}{"gw_names": wave})
+	}
+	if err := d.Set("waves", wavesList); err != nil {
+		return fmt.Errorf("failed to set waves: %v", err)
+	}
+
+	var upgrades []map[string]interface{}
+	for _, result := range results {
+		upgrades = append(upgrades, map[string]interface{}{
+			"gw_name":          result.GwName,
+			"wave":             result.Wave,
+			"status":           result.Status,
+			"software_version": result.SoftwareVersion,
+			"image_version":    result.ImageVersion,
+			"message":          result.Message,
+		})
+	}
+	if err := d.Set("gateway_upgrades", upgrades); err != nil {
+		return fmt.Errorf("failed to set gateway_upgrades: %v", err)
+	}
+
+	if planErr != nil {
+		return fmt.Errorf("%v\n%s", planErr, gatewayUpgradeReport(results))
+	}
+	return nil
+}
+
+// checkGatewayUpgradeHealth checks the health of the gateway and refreshes the versions of the result.
+func checkGatewayUpgradeHealth(ctx context.Context, client *goaviatrix.Client, result *gatewayUpgradeResult, verifyTunnels bool) error {
+	if err := checkGatewayHealth(ctx, client, result.GwName, verifyTunnels); err != nil {
+		return err
+	}
+
+	gw, err := client.GetGateway(&goaviatrix.Gateway{GwName: result.GwName})
+	if err != nil {
+		return err
+	}
+	result.SoftwareVersion = gw.SoftwareVersion
+	result.ImageVersion = gw.ImageVersion
+	return nil
+}
+
+func checkGatewayHealth(ctx context.Context, client *goaviatrix.Client, gwName string, verifyTunnels bool) error {
+	health, err := client.GetGatewayHealth(ctx, gwName)
+	if err != nil {
+		return err
+	}
+	if !health.Healthy(verifyTunnels) {
+		return fmt.Errorf("gateway status is %q with %d tunnels down", health.Status, health.TunnelsDown)
+	}
+	return nil
+}
+
+func waitForGatewayHealth(ctx context.Context, client *goaviatrix.Client, gwName string, verifyTunnels bool, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		err := checkGatewayHealth(ctx, client, gwName, verifyTunnels)
+		if err == nil {
+			return nil
+		}
+		if time.Now().Add(gatewayUpgradeHealthCheckInterval).After(deadline) {
+			return fmt.Errorf("waited %s: %v", timeout, err)
+		}
+		log.Printf("[DEBUG] Waiting for gateway %s to become healthy: %v", gwName, err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(gatewayUpgradeHealthCheckInterval):
+		}
+	}
+}
+
+func gatewayUpgradeReport(results []*gatewayUpgradeResult) string {
+	var sb strings.Builder
+	sb.WriteString("Gateway upgrade report:")
+	for _, result := range results {
+		sb.WriteString(fmt.Sprintf("\n  wave %d: %s %s (software_version=%s image_version=%s)",
+			result.Wave, result.GwName, result.Status, result.SoftwareVersion, result.ImageVersion))
+		if result.Message != "" {
+			sb.WriteString(": " + result.Message)
+		}
+	}
+	return sb.String()
+}
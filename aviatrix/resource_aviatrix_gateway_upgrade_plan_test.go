@@ -0,0 +1,95 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixGatewayUpgradePlan_basic(t *testing.T) {
+	if os.Getenv("SKIP_GATEWAY_UPGRADE_PLAN") == "yes" {
+		t.Skip("Skipping Gateway Upgrade Plan test as SKIP_GATEWAY_UPGRADE_PLAN is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_gateway_upgrade_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, ". Set SKIP_GATEWAY_UPGRADE_PLAN to yes to skip Gateway Upgrade Plan tests.")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayUpgradePlanBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayUpgradePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "completed"),
+					resource.TestCheckResourceAttr(resourceName, "waves.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "waves.0.gw_names.0", fmt.Sprintf("tfg-canary-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "gateway_upgrades.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGatewayUpgradePlanBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test" {
+	account_name       = "tfa-%[1]s"
+	cloud_type         = 1
+	aws_account_number = "%[2]s"
+	aws_iam            = false
+	aws_access_key     = "%[3]s"
+	aws_secret_key     = "%[4]s"
+}
+
+resource "aviatrix_gateway" "canary" {
+	cloud_type   = 1
+	account_name = aviatrix_account.test.account_name
+	gw_name      = "tfg-canary-%[1]s"
+	vpc_id       = "%[5]s"
+	vpc_reg      = "%[6]s"
+	gw_size      = "t2.micro"
+	subnet       = "%[7]s"
+}
+
+resource "aviatrix_gateway" "test" {
+	cloud_type         = 1
+	account_name       = aviatrix_account.test.account_name
+	gw_name            = "tfg-%[1]s"
+	vpc_id             = "%[5]s"
+	vpc_reg            = "%[6]s"
+	gw_size            = "t2.micro"
+	subnet             = "%[7]s"
+	peering_ha_subnet  = "%[7]s"
+	peering_ha_gw_size = "t2.micro"
+}
+
+resource "aviatrix_gateway_upgrade_plan" "test" {
+	gw_names        = [aviatrix_gateway.canary.gw_name, aviatrix_gateway.test.gw_name]
+	canary_gw_names = [aviatrix_gateway.canary.gw_name]
+	max_parallel    = 2
+}
+`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"))
+}
+
+func testAccCheckGatewayUpgradePlanExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("gateway upgrade plan Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no gateway upgrade plan ID is set")
+		}
+		return nil
+	}
+}
@@ -0,0 +1,50 @@
+package aviatrix
+
+import (
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixGcpAccount() *schema.Resource {
+	return resourceAviatrixCloudAccount(cloudAccount{
+		name:                 "GCP",
+		cloudTypes:           goaviatrix.GCP,
+		credentialAttributes: []string{"credentials_filepath"},
+		// The previous credentials file may have been replaced in place
+		restorableCredentials: false,
+		expand:                expandGcpAccount,
+		flatten:               flattenGcpAccount,
+		create: func(client *goaviatrix.Client, account *goaviatrix.Account) error {
+			return client.CreateGCPAccount(account)
+		},
+		update: func(client *goaviatrix.Client, account *goaviatrix.Account) error {
+			return client.UpdateGCPAccount(account)
+		},
+		schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "GCloud Project ID.",
+			},
+			"credentials_filepath": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "GCloud Project credentials local file path.",
+			},
+		},
+	})
+}
+
+func expandGcpAccount(get func(key string) interface{}, account *goaviatrix.Account) error {
+	account.CloudType = goaviatrix.GCP
+	account.GcloudProjectName = get("project_id").(string)
+	account.GcloudProjectCredentialsFilepathLocal = get("credentials_filepath").(string)
+	return nil
+}
+
+func flattenGcpAccount(d *schema.ResourceData, account *goaviatrix.Account) {
+	d.Set("project_id", account.GcloudProjectName)
+}
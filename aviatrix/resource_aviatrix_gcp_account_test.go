@@ -0,0 +1,58 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAviatrixGcpAccount_basic(t *testing.T) {
+	var account goaviatrix.Account
+
+	rInt := acctest.RandInt()
+	resourceName := "aviatrix_gcp_account.test"
+
+	skipAcc := os.Getenv("SKIP_GCP_ACCOUNT")
+	if skipAcc == "yes" {
+		t.Skip("Skipping GCP Account test as SKIP_GCP_ACCOUNT is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preAccountCheck(t, ". Set SKIP_GCP_ACCOUNT to yes to skip GCP account tests")
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudAccountDestroy("aviatrix_gcp_account"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGcpAccountConfigBasic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountExists(resourceName, &account),
+					resource.TestCheckResourceAttr(resourceName, "account_name", fmt.Sprintf("tfa-gcp-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "project_id", os.Getenv("GCP_ID")),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials_filepath", "audit_account"},
+			},
+		},
+	})
+}
+
+func testAccGcpAccountConfigBasic(rInt int) string {
+	return fmt.Sprintf(`
+resource "aviatrix_gcp_account" "test" {
+	account_name         = "tfa-gcp-%d"
+	project_id           = "%s"
+	credentials_filepath = "%s"
+}
+	`, rInt, os.Getenv("GCP_ID"), os.Getenv("GCP_CREDENTIALS_FILEPATH"))
+}
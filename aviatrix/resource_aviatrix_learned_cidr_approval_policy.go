@@ -0,0 +1,178 @@
+package aviatrix
+
+import (
+	"context"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixLearnedCidrApprovalPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixLearnedCidrApprovalPolicyCreate,
+		ReadWithoutTimeout:   resourceAviatrixLearnedCidrApprovalPolicyRead,
+		UpdateWithoutTimeout: resourceAviatrixLearnedCidrApprovalPolicyUpdate,
+		DeleteWithoutTimeout: resourceAviatrixLearnedCidrApprovalPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceAviatrixLearnedCidrApprovalPolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"gw_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the transit gateway.",
+			},
+			"connection_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the connection of the transit gateway. Required if the learned CIDRs approval mode of the gateway is 'connection', and must be empty if it is 'gateway'.",
+			},
+			"approved_supernets": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIPv4CIDRNetwork,
+				},
+				Description: "IPv4 CIDRs. Learned CIDRs contained in one of these supernets are approved, the others are rejected.",
+			},
+			"max_prefix_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 32),
+				Description:  "Learned CIDRs with a longer prefix are rejected even if they are contained in an approved supernet.",
+			},
+			"approved_learned_cidrs": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Approved learned CIDRs.",
+			},
+			"rejected_learned_cidrs": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Learned CIDRs rejected by the last apply of the policy.",
+			},
+			"pending_learned_cidrs": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Learned CIDRs waiting for approval. They are approved or rejected by the next apply of the policy.",
+			},
+		},
+	}
+}
+
+func marshalLearnedCidrApprovalRulesInput(d *schema.ResourceData) *goaviatrix.LearnedCidrApprovalRules {
+	return &goaviatrix.LearnedCidrApprovalRules{
+		Supernets:       getStringSet(d, "approved_supernets"),
+		MaxPrefixLength: d.Get("max_prefix_length").(int),
+	}
+}
+
+func resourceAviatrixLearnedCidrApprovalPolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Learned CIDRs that became pending since the last apply must be approved or rejected by the next one.
+	if d.Id() == "" || d.Get("pending_learned_cidrs").(*schema.Set).Len() == 0 {
+		return nil
+	}
+	for _, k := range []string{"approved_learned_cidrs", "rejected_learned_cidrs", "pending_learned_cidrs"} {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyLearnedCidrApprovalPolicy(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client) diag.Diagnostics {
+	gwName := d.Get("gw_name").(string)
+	connName := d.Get("connection_name").(string)
+
+	_, rejected, err := client.ApplyLearnedCidrApprovalRules(ctx, gwName, connName, marshalLearnedCidrApprovalRulesInput(d))
+	if err != nil {
+		if err == goaviatrix.ErrNotFound {
+			if connName == "" {
+				return diag.Errorf("learned CIDRs approval is not enabled on transit gateway %s", gwName)
+			}
+			return diag.Errorf("learned CIDRs approval is not enabled on connection %s of transit gateway %s", connName, gwName)
+		}
+		return diag.Errorf("failed to apply learned CIDR approval policy to %s: %v", gwName+"~"+connName, err)
+	}
+
+	if err := d.Set("rejected_learned_cidrs", rejected); err != nil {
+		return diag.Errorf("failed to set rejected_learned_cidrs: %v", err)
+	}
+	return nil
+}
+
+func resourceAviatrixLearnedCidrApprovalPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if diags := applyLearnedCidrApprovalPolicy(ctx, d, client); diags != nil {
+		return diags
+	}
+
+	d.SetId(d.Get("gw_name").(string) + "~" + d.Get("connection_name").(string))
+	return resourceAviatrixLearnedCidrApprovalPolicyRead(ctx, d, meta)
+}
+
+func resourceAviatrixLearnedCidrApprovalPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if d.Get("gw_name").(string) == "" {
+		id := d.Id()
+		parts := strings.Split(id, "~")
+		if len(parts) != 2 || parts[0] == "" {
+			return diag.Errorf("invalid ID %q, expected gw_name~connection_name or gw_name~", id)
+		}
+		d.Set("gw_name", parts[0])
+		d.Set("connection_name", parts[1])
+	}
+
+	gwName := d.Get("gw_name").(string)
+	connName := d.Get("connection_name").(string)
+
+	learnedCidrs, err := client.GetConnectionPendingLearnedCidrs(ctx, gwName, connName)
+	if err != nil {
+		if err == goaviatrix.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read learned CIDRs of %s: %v", d.Id(), err)
+	}
+
+	if err := d.Set("approved_learned_cidrs", learnedCidrs.ApprovedCidrs); err != nil {
+		return diag.Errorf("failed to set approved_learned_cidrs: %v", err)
+	}
+	if err := d.Set("pending_learned_cidrs", learnedCidrs.PendingCidrs); err != nil {
+		return diag.Errorf("failed to set pending_learned_cidrs: %v", err)
+	}
+
+	d.SetId(gwName + "~" + connName)
+	return nil
+}
+
+func resourceAviatrixLearnedCidrApprovalPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if diags := applyLearnedCidrApprovalPolicy(ctx, d, client); diags != nil {
+		return diags
+	}
+
+	return resourceAviatrixLearnedCidrApprovalPolicyRead(ctx, d, meta)
+}
+
+func resourceAviatrixLearnedCidrApprovalPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The approved learned CIDRs are left as they are, the gateway or connection keeps propagating them.
+	return nil
+}
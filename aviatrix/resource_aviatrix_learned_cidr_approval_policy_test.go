@@ -0,0 +1,111 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixLearnedCidrApprovalPolicy_basic(t *testing.T) {
+	if os.Getenv("SKIP_LEARNED_CIDR_APPROVAL_POLICY") == "yes" {
+		t.Skip("Skipping learned CIDR approval policy test as SKIP_LEARNED_CIDR_APPROVAL_POLICY is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_learned_cidr_approval_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, ". Set SKIP_LEARNED_CIDR_APPROVAL_POLICY to yes to skip learned CIDR approval policy tests")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLearnedCidrApprovalPolicyBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLearnedCidrApprovalPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connection_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "approved_supernets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_prefix_length", "24"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"approved_supernets", "max_prefix_length", "rejected_learned_cidrs"},
+			},
+		},
+	})
+}
+
+func testAccLearnedCidrApprovalPolicyBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test" {
+	account_name       = "tfa-%[1]s"
+	cloud_type         = 1
+	aws_account_number = "%[2]s"
+	aws_iam            = false
+	aws_access_key     = "%[3]s"
+	aws_secret_key     = "%[4]s"
+}
+
+resource "aviatrix_transit_gateway" "test" {
+	cloud_type                  = 1
+	account_name                = aviatrix_account.test.account_name
+	gw_name                     = "tfg-%[1]s"
+	vpc_id                      = "%[5]s"
+	vpc_reg                     = "%[6]s"
+	gw_size                     = "t2.micro"
+	subnet                      = "%[7]s"
+	local_as_number             = "65001"
+	learned_cidrs_approval_mode = "connection"
+}
+
+resource "aviatrix_transit_external_device_conn" "test" {
+	vpc_id                        = aviatrix_transit_gateway.test.vpc_id
+	connection_name               = "%[1]s"
+	gw_name                       = aviatrix_transit_gateway.test.gw_name
+	connection_type               = "bgp"
+	bgp_local_as_num              = "65001"
+	bgp_remote_as_num             = "65002"
+	remote_gateway_ip             = "172.12.13.14"
+	enable_learned_cidrs_approval = true
+}
+
+resource "aviatrix_learned_cidr_approval_policy" "test" {
+	gw_name            = aviatrix_transit_external_device_conn.test.gw_name
+	connection_name    = aviatrix_transit_external_device_conn.test.connection_name
+	approved_supernets = ["10.0.0.0/8"]
+	max_prefix_length  = 24
+}
+`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"))
+}
+
+func testAccCheckLearnedCidrApprovalPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("learned CIDR approval policy not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no learned CIDR approval policy ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		_, err := client.GetConnectionPendingLearnedCidrs(context.Background(), rs.Primary.Attributes["gw_name"], rs.Primary.Attributes["connection_name"])
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
@@ -0,0 +1,85 @@
+package aviatrix
+
+import (
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// OCI accounts can't be updated on the controller, so all of their attributes force a new account. The ones
+// the controller doesn't return are empty after an import, which must not force a new account.
+func resourceAviatrixOciAccount() *schema.Resource {
+	return resourceAviatrixCloudAccount(cloudAccount{
+		name:       "OCI",
+		cloudTypes: goaviatrix.OCI,
+		expand:     expandOciAccount,
+		flatten:    flattenOciAccount,
+		create: func(client *goaviatrix.Client, account *goaviatrix.Account) error {
+			return client.CreateOCIAccount(account)
+		},
+		schema: map[string]*schema.Schema{
+			"tenancy_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressImportedOciAccountDiff,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				Description:      "OCI tenancy OCID.",
+			},
+			"user_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressImportedOciAccountDiff,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				Description:      "OCI user OCID.",
+			},
+			"compartment_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressImportedOciAccountDiff,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				Description:      "OCI compartment OCID.",
+			},
+			"api_private_key_filepath": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressImportedOciAccountDiff,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				Description:      "OCI API private key local file path.",
+			},
+		},
+	})
+}
+
+func expandOciAccount(get func(key string) interface{}, account *goaviatrix.Account) error {
+	account.CloudType = goaviatrix.OCI
+	account.OciTenancyID = get("tenancy_id").(string)
+	account.OciUserID = get("user_id").(string)
+	account.OciCompartmentID = get("compartment_id").(string)
+	account.OciApiPrivateKeyFilePath = get("api_private_key_filepath").(string)
+	return nil
+}
+
+func flattenOciAccount(d *schema.ResourceData, account *goaviatrix.Account) {
+	for k, v := range map[string]string{
+		"tenancy_id":               account.OciTenancyID,
+		"user_id":                  account.OciUserID,
+		"compartment_id":           account.OciCompartmentID,
+		"api_private_key_filepath": account.OciApiPrivateKeyFilePath,
+	} {
+		if v != "" {
+			d.Set(k, v)
+		}
+	}
+}
+
+func suppressImportedOciAccountDiff(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && old == ""
+}
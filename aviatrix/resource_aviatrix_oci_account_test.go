@@ -0,0 +1,72 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAviatrixOciAccount_basic(t *testing.T) {
+	var account goaviatrix.Account
+
+	rInt := acctest.RandInt()
+	resourceName := "aviatrix_oci_account.test"
+
+	skipAcc := os.Getenv("SKIP_OCI_ACCOUNT")
+	if skipAcc == "yes" {
+		t.Skip("Skipping OCI Account test as SKIP_OCI_ACCOUNT is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preAccountCheck(t, ". Set SKIP_OCI_ACCOUNT to yes to skip OCI account tests")
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudAccountDestroy("aviatrix_oci_account"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOciAccountConfigBasic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountExists(resourceName, &account),
+					resource.TestCheckResourceAttr(resourceName, "account_name", fmt.Sprintf("tfa-oci-%d", rInt)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{"tenancy_id", "user_id", "compartment_id",
+					"api_private_key_filepath", "audit_account"},
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateCheck: testAccCheckImportedCloudAccountPlanEmpty("aviatrix_oci_account", map[string]interface{}{
+					"account_name":             fmt.Sprintf("tfa-oci-%d", rInt),
+					"tenancy_id":               os.Getenv("OCI_TENANCY_ID"),
+					"user_id":                  os.Getenv("OCI_USER_ID"),
+					"compartment_id":           os.Getenv("OCI_COMPARTMENT_ID"),
+					"api_private_key_filepath": os.Getenv("OCI_API_KEY_FILEPATH"),
+				}),
+			},
+		},
+	})
+}
+
+func testAccOciAccountConfigBasic(rInt int) string {
+	return fmt.Sprintf(`
+resource "aviatrix_oci_account" "test" {
+	account_name             = "tfa-oci-%d"
+	tenancy_id               = "%s"
+	user_id                  = "%s"
+	compartment_id           = "%s"
+	api_private_key_filepath = "%s"
+}
+	`, rInt, os.Getenv("OCI_TENANCY_ID"), os.Getenv("OCI_USER_ID"),
+		os.Getenv("OCI_COMPARTMENT_ID"), os.Getenv("OCI_API_KEY_FILEPATH"))
+}
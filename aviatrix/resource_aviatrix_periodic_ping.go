@@ -34,21 +34,88 @@ func resourceAviatrixPeriodicPing() *schema.Resource {
 			},
 			"ip_address": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.IsIPAddress,
+				ExactlyOneOf: []string{"ip_address", "ip_addresses"},
+				Deprecated:   "Please use ip_addresses instead.",
 				Description:  "IP Address to ping.",
 			},
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+				ExactlyOneOf: []string{"ip_address", "ip_addresses"},
+				Description:  "List of IP Addresses to ping.",
+			},
+			"ping_results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        periodicPingResultSchema(),
+				Description: "Last known ping result of each target.",
+			},
+		},
+	}
+}
+
+func periodicPingResultSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Target IP Address.",
+			},
+			"reachable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the target replied to the last ping.",
+			},
+			"latency": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Round trip time of the last ping in milliseconds.",
+			},
+			"last_ping_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time of the last ping.",
+			},
 		},
 	}
 }
 
 func marshalPeriodicPingInput(d *schema.ResourceData) *goaviatrix.PeriodicPing {
-	return &goaviatrix.PeriodicPing{
+	pp := &goaviatrix.PeriodicPing{
 		GwName:   d.Get("gw_name").(string),
 		Interval: strconv.Itoa(d.Get("interval").(int)),
 		IP:       d.Get("ip_address").(string),
 	}
+
+	if _, ok := d.GetOk("ip_addresses"); ok {
+		pp.IPs = getStringList(d, "ip_addresses")
+	}
+
+	return pp
+}
+
+func flattenPeriodicPingResults(results []goaviatrix.PeriodicPingTargetResult) []map[string]interface{} {
+	var pingResults []map[string]interface{}
+	for _, result := range results {
+		pingResults = append(pingResults, map[string]interface{}{
+			"ip_address":     result.IP,
+			"reachable":      result.IsReachable(),
+			"latency":        result.Latency,
+			"last_ping_time": result.LastPingTime,
+		})
+	}
+	return pingResults
 }
 
 func resourceAviatrixPeriodicPingCreate(d *schema.ResourceData, meta interface{}) error {
@@ -102,6 +169,12 @@ func resourceAviatrixPeriodicPingRead(d *schema.ResourceData, meta interface{})
 	d.Set("gw_name", gwName)
 	d.Set("ip_address", pp.IP)
 	d.Set("interval", pp.IntervalAsInt)
+	if err := setConfigValueIfEquivalent(d, "ip_addresses", getStringList(d, "ip_addresses"), pp.IPs); err != nil {
+		return fmt.Errorf("failed to set ip_addresses: %v", err)
+	}
+	if err := d.Set("ping_results", flattenPeriodicPingResults(pp.PingResults)); err != nil {
+		return fmt.Errorf("failed to set ping_results: %v", err)
+	}
 
 	d.SetId(pp.GwName)
 	return nil
@@ -18,6 +18,7 @@ func TestAccAviatrixPeriodicPing_basic(t *testing.T) {
 	}
 
 	resourceName := "aviatrix_periodic_ping.test_periodic_ping"
+	rName := acctest.RandString(5)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -28,7 +29,7 @@ func TestAccAviatrixPeriodicPing_basic(t *testing.T) {
 		CheckDestroy: testAccCheckPeriodicPingDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPeriodicPingBasic(acctest.RandString(5)),
+				Config: testAccPeriodicPingBasic(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPeriodicPingExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "interval", "5"),
@@ -40,6 +41,16 @@ func TestAccAviatrixPeriodicPing_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccPeriodicPingMultipleTargets(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPeriodicPingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "interval", "5"),
+					resource.TestCheckResourceAttr(resourceName, "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ip_addresses.0", "127.0.0.1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_addresses.1", "127.0.0.2"),
+				),
+			},
 		},
 	})
 }
@@ -74,6 +85,36 @@ resource "aviatrix_periodic_ping" "test_periodic_ping" {
 		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"))
 }
 
+func testAccPeriodicPingMultipleTargets(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test" {
+	account_name       = "tfa-%[1]s"
+	cloud_type         = 1
+	aws_account_number = "%[2]s"
+	aws_iam            = false
+	aws_access_key     = "%[3]s"
+	aws_secret_key     = "%[4]s"
+}
+
+resource "aviatrix_gateway" "test_gw" {
+	cloud_type   = 1
+	account_name = aviatrix_account.test.account_name
+	gw_name      = "tfg-%[1]s"
+	vpc_id       = "%[5]s"
+	vpc_reg      = "%[6]s"
+	gw_size      = "t2.micro"
+	subnet       = "%[7]s"
+}
+
+resource "aviatrix_periodic_ping" "test_periodic_ping" {
+	gw_name      = aviatrix_gateway.test_gw.gw_name
+	interval     = 5
+	ip_addresses = ["127.0.0.1", "127.0.0.2"]
+}
+`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"))
+}
+
 func testAccCheckPeriodicPingExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1,6 +1,7 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -17,6 +18,7 @@ func resourceAviatrixRbacGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceAviatrixRbacGroupCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"group_name": {
@@ -31,6 +33,19 @@ func resourceAviatrixRbacGroup() *schema.Resource {
 				Default:     false,
 				Description: "Whether to allow members of an RBAC group to bypass LDAP/MFA for Duo login",
 			},
+			"manage_permissions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Manage the permissions of the group with 'permissions'. Must be false if the permissions " +
+					"are managed with 'aviatrix_rbac_group_permission_attachment' resources.",
+			},
+			"permissions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Full set of permission names of the group. Only valid when 'manage_permissions' is true.",
+			},
 		},
 	}
 }
@@ -63,6 +78,12 @@ func resourceAviatrixRbacGroupCreate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.Get("manage_permissions").(bool) {
+		if err := reconcileRbacGroupPermissions(client, d); err != nil {
+			return err
+		}
+	}
+
 	return resourceAviatrixRbacGroupReadIfRequired(d, meta, &flag)
 }
 
@@ -70,15 +91,23 @@ func resourceAviatrixRbacGroupUpdate(d *schema.ResourceData, meta interface{}) e
 	client := meta.(*goaviatrix.Client)
 	groupName := d.Get("group_name").(string)
 
-	if d.Get("local_login").(bool) {
-		err := client.EnableLocalLoginForRBACGroup(groupName)
-		if err != nil {
-			return fmt.Errorf("failed to enable local_login for Aviatrix RBAC permission group: %s", err)
+	if d.HasChange("local_login") {
+		if d.Get("local_login").(bool) {
+			err := client.EnableLocalLoginForRBACGroup(groupName)
+			if err != nil {
+				return fmt.Errorf("failed to enable local_login for Aviatrix RBAC permission group: %s", err)
+			}
+		} else {
+			err := client.DisableLocalLoginForRBACGroup(groupName)
+			if err != nil {
+				return fmt.Errorf("failed to disable local_login for Aviatrix RBAC permission group: %s", err)
+			}
 		}
-	} else {
-		err := client.DisableLocalLoginForRBACGroup(groupName)
-		if err != nil {
-			return fmt.Errorf("failed to disable local_login for Aviatrix RBAC permission group: %s", err)
+	}
+
+	if d.Get("manage_permissions").(bool) && d.HasChanges("manage_permissions", "permissions") {
+		if err := reconcileRbacGroupPermissions(client, d); err != nil {
+			return err
 		}
 	}
 	return resourceAviatrixRbacGroupRead(d, meta)
@@ -100,6 +129,7 @@ func resourceAviatrixRbacGroupRead(d *schema.ResourceData, meta interface{}) err
 		id := d.Id()
 		log.Printf("[DEBUG] Looks like an import, no group name received. Import Id is %s", id)
 		d.Set("group_name", id)
+		d.Set("manage_permissions", false)
 		d.SetId(id)
 		groupName = id
 	}
@@ -124,6 +154,22 @@ func resourceAviatrixRbacGroupRead(d *schema.ResourceData, meta interface{}) err
 		d.SetId(rGroup.GroupName)
 	}
 
+	if d.Get("manage_permissions").(bool) {
+		permissions, err := client.ListRbacGroupPermissions(groupName)
+		if err != nil {
+			return fmt.Errorf("failed to list permissions of Aviatrix RBAC permission group: %s", err)
+		}
+		var permissionNames []string
+		for _, permission := range permissions {
+			permissionNames = append(permissionNames, permission.Name)
+		}
+		if err := d.Set("permissions", permissionNames); err != nil {
+			log.Printf("[WARN] Error setting 'permissions' for (%s): %s", d.Id(), err)
+		}
+	} else {
+		d.Set("permissions", nil)
+	}
+
 	return nil
 }
 
@@ -143,3 +189,59 @@ func resourceAviatrixRbacGroupDelete(d *schema.ResourceData, meta interface{}) e
 
 	return nil
 }
+
+// reconcileRbacGroupPermissions attaches and detaches permissions so that the group has exactly the
+// permissions in 'permissions'.
+func reconcileRbacGroupPermissions(client *goaviatrix.Client, d *schema.ResourceData) error {
+	groupName := d.Get("group_name").(string)
+
+	current, err := client.ListRbacGroupPermissions(groupName)
+	if err != nil {
+		return fmt.Errorf("failed to list permissions of Aviatrix RBAC permission group: %s", err)
+	}
+	var currentNames []string
+	for _, permission := range current {
+		currentNames = append(currentNames, permission.Name)
+	}
+	desiredNames := getStringSet(d, "permissions")
+
+	return reconcileRbacGroupList(groupName, "permissions", currentNames, desiredNames,
+		client.AttachRbacGroupPermissions, client.DetachRbacGroupPermissions)
+}
+
+// reconcileRbacGroupList attaches and detaches the items of one of the lists of a group, such as its
+// permissions, so that the list changes from current to desired.
+func reconcileRbacGroupList(groupName, kind string, current, desired []string, attach, detach func(string, []string) error) error {
+	toDetach := goaviatrix.Difference(current, desired)
+	toAttach := goaviatrix.Difference(desired, current)
+
+	if len(toDetach) != 0 {
+		log.Printf("[INFO] Detaching %s %v from Aviatrix RBAC permission group %s", kind, toDetach, groupName)
+		if err := detach(groupName, toDetach); err != nil {
+			return fmt.Errorf("failed to detach %s from Aviatrix RBAC permission group: %s", kind, err)
+		}
+	}
+	if len(toAttach) != 0 {
+		log.Printf("[INFO] Attaching %s %v to Aviatrix RBAC permission group %s", kind, toAttach, groupName)
+		if err := attach(groupName, toAttach); err != nil {
+			return fmt.Errorf("failed to attach %s to Aviatrix RBAC permission group: %s", kind, err)
+		}
+	}
+	return nil
+}
+
+func resourceAviatrixRbacGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("manage_permissions").(bool) {
+		if d.NewValueKnown("permissions") && d.Get("permissions").(*schema.Set).Len() != 0 {
+			return fmt.Errorf("'permissions' is only valid when 'manage_permissions' is true")
+		}
+		return nil
+	}
+	if !d.HasChange("permissions") || !d.NewValueKnown("permissions") {
+		return nil
+	}
+
+	client := meta.(*goaviatrix.Client)
+	permissionNames := goaviatrix.ExpandStringList(d.Get("permissions").(*schema.Set).List())
+	return goaviatrix.ValidateRbacPermissionNames(permissionNames, rbacPermissionNames(client))
+}
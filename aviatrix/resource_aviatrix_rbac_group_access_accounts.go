@@ -0,0 +1,145 @@
+package aviatrix
+
+import (
+	"context"
+	"log"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixRbacGroupAccessAccounts() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixRbacGroupAccessAccountsCreate,
+		ReadWithoutTimeout:   resourceAviatrixRbacGroupAccessAccountsRead,
+		UpdateWithoutTimeout: resourceAviatrixRbacGroupAccessAccountsUpdate,
+		DeleteWithoutTimeout: resourceAviatrixRbacGroupAccessAccountsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "RBAC permission group name.",
+			},
+			"access_account_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				Description: "Full set of access account names of the group, or 'all' for all accounts. Access " +
+					"accounts of the group that are not in this set are removed from the group.",
+			},
+		},
+	}
+}
+
+func resourceAviatrixRbacGroupAccessAccountsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	groupName := d.Get("group_name").(string)
+
+	log.Printf("[INFO] Creating Aviatrix RBAC group access accounts: %s", groupName)
+
+	d.SetId(groupName)
+	flag := false
+	defer resourceAviatrixRbacGroupAccessAccountsReadIfRequired(ctx, d, meta, &flag)
+
+	if err := reconcileRbacGroupAccessAccounts(client, d); err != nil {
+		return diag.Errorf("failed to create Aviatrix RBAC group access accounts: %s", err)
+	}
+
+	return resourceAviatrixRbacGroupAccessAccountsReadIfRequired(ctx, d, meta, &flag)
+}
+
+func resourceAviatrixRbacGroupAccessAccountsReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
+	if !(*flag) {
+		*flag = true
+		return resourceAviatrixRbacGroupAccessAccountsRead(ctx, d, meta)
+	}
+	return nil
+}
+
+func resourceAviatrixRbacGroupAccessAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	groupName := d.Get("group_name").(string)
+	if groupName == "" {
+		id := d.Id()
+		log.Printf("[DEBUG] Looks like an import, no group name received. Import Id is %s", id)
+		d.Set("group_name", id)
+		d.SetId(id)
+		groupName = id
+	}
+
+	_, err := client.GetPermissionGroup(&goaviatrix.RbacGroup{GroupName: groupName})
+	if err != nil {
+		if err == goaviatrix.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("couldn't find Aviatrix RBAC permission group: %s", err)
+	}
+
+	accountNames, err := client.ListRbacGroupAccessAccounts(groupName)
+	if err != nil {
+		return diag.Errorf("failed to list access accounts of Aviatrix RBAC permission group: %s", err)
+	}
+	if err := d.Set("access_account_names", accountNames); err != nil {
+		return diag.Errorf("failed to set access_account_names: %s", err)
+	}
+
+	d.SetId(groupName)
+	return nil
+}
+
+func resourceAviatrixRbacGroupAccessAccountsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if d.HasChange("access_account_names") {
+		if err := reconcileRbacGroupAccessAccounts(client, d); err != nil {
+			return diag.Errorf("failed to update Aviatrix RBAC group access accounts: %s", err)
+		}
+	}
+
+	return resourceAviatrixRbacGroupAccessAccountsRead(ctx, d, meta)
+}
+
+func resourceAviatrixRbacGroupAccessAccountsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	groupName := d.Get("group_name").(string)
+
+	log.Printf("[INFO] Deleting Aviatrix RBAC group access accounts: %s", groupName)
+
+	accountNames := getStringSet(d, "access_account_names")
+	if len(accountNames) == 0 {
+		return nil
+	}
+	if err := client.DetachRbacGroupAccessAccounts(groupName, accountNames); err != nil {
+		return diag.Errorf("failed to delete Aviatrix RBAC group access accounts: %s", err)
+	}
+
+	return nil
+}
+
+// reconcileRbacGroupAccessAccounts adds and removes access accounts so that the group has exactly the access
+// accounts in 'access_account_names'.
+func reconcileRbacGroupAccessAccounts(client *goaviatrix.Client, d *schema.ResourceData) error {
+	groupName := d.Get("group_name").(string)
+
+	current, err := client.ListRbacGroupAccessAccounts(groupName)
+	if err != nil {
+		return err
+	}
+
+	return reconcileRbacGroupList(groupName, "access accounts", current, getStringSet(d, "access_account_names"),
+		client.AttachRbacGroupAccessAccounts, client.DetachRbacGroupAccessAccounts)
+}
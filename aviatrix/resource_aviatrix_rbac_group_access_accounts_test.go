@@ -0,0 +1,99 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixRbacGroupAccessAccounts_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+
+	skipAcc := os.Getenv("SKIP_RBAC_GROUP_ACCESS_ACCOUNTS")
+	if skipAcc == "yes" {
+		t.Skip("Skipping rbac group access accounts tests as SKIP_RBAC_GROUP_ACCESS_ACCOUNTS is set")
+	}
+
+	resourceName := "aviatrix_rbac_group_access_accounts.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preAccountCheck(t, ". Set SKIP_RBAC_GROUP_ACCESS_ACCOUNTS to 'yes' to skip rbac group access accounts tests")
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRbacGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRbacGroupAccessAccountsConfigBasic(rName, `aviatrix_account.test.account_name, "all"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRbacGroupAccessAccountsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "group_name", fmt.Sprintf("tf-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "access_account_names.#", "2"),
+				),
+			},
+			{
+				Config: testAccRbacGroupAccessAccountsConfigBasic(rName, "aviatrix_account.test.account_name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRbacGroupAccessAccountsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_account_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "access_account_names.*", fmt.Sprintf("tf-acc-%s", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRbacGroupAccessAccountsConfigBasic(rName string, accountNames string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_rbac_group" "test" {
+	group_name = "tf-%[1]s"
+}
+resource "aviatrix_account" "test" {
+	account_name       = "tf-acc-%[1]s"
+	cloud_type         = 1
+	aws_account_number = "%[3]s"
+	aws_iam            = false
+	aws_access_key     = "%[4]s"
+	aws_secret_key     = "%[5]s"
+}
+resource "aviatrix_rbac_group_access_accounts" "test" {
+	group_name = aviatrix_rbac_group.test.group_name
+	access_account_names = [%[2]s]
+}
+	`, rName, accountNames, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"))
+}
+
+func testAccCheckRbacGroupAccessAccountsExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("RbacGroupAccessAccounts Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no RbacGroupAccessAccounts ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		accountNames, err := client.ListRbacGroupAccessAccounts(rs.Primary.Attributes["group_name"])
+		if err != nil {
+			return err
+		}
+		if strconv.Itoa(len(accountNames)) != rs.Primary.Attributes["access_account_names.#"] {
+			return fmt.Errorf("RBAC group has access accounts %v, expected %s access accounts", accountNames, rs.Primary.Attributes["access_account_names.#"])
+		}
+		return nil
+	}
+}
@@ -0,0 +1,144 @@
+package aviatrix
+
+import (
+	"context"
+	"log"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixRbacGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixRbacGroupMembershipCreate,
+		ReadWithoutTimeout:   resourceAviatrixRbacGroupMembershipRead,
+		UpdateWithoutTimeout: resourceAviatrixRbacGroupMembershipUpdate,
+		DeleteWithoutTimeout: resourceAviatrixRbacGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "RBAC permission group name.",
+			},
+			"user_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				Description: "Full set of account user names of the group. Users of the group that are not in " +
+					"this set are removed from the group.",
+			},
+		},
+	}
+}
+
+func resourceAviatrixRbacGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	groupName := d.Get("group_name").(string)
+
+	log.Printf("[INFO] Creating Aviatrix RBAC group membership: %s", groupName)
+
+	d.SetId(groupName)
+	flag := false
+	defer resourceAviatrixRbacGroupMembershipReadIfRequired(ctx, d, meta, &flag)
+
+	if err := reconcileRbacGroupUsers(client, d); err != nil {
+		return diag.Errorf("failed to create Aviatrix RBAC group membership: %s", err)
+	}
+
+	return resourceAviatrixRbacGroupMembershipReadIfRequired(ctx, d, meta, &flag)
+}
+
+func resourceAviatrixRbacGroupMembershipReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
+	if !(*flag) {
+		*flag = true
+		return resourceAviatrixRbacGroupMembershipRead(ctx, d, meta)
+	}
+	return nil
+}
+
+func resourceAviatrixRbacGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	groupName := d.Get("group_name").(string)
+	if groupName == "" {
+		id := d.Id()
+		log.Printf("[DEBUG] Looks like an import, no group name received. Import Id is %s", id)
+		d.Set("group_name", id)
+		d.SetId(id)
+		groupName = id
+	}
+
+	_, err := client.GetPermissionGroup(&goaviatrix.RbacGroup{GroupName: groupName})
+	if err != nil {
+		if err == goaviatrix.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("couldn't find Aviatrix RBAC permission group: %s", err)
+	}
+
+	userNames, err := client.ListRbacGroupUsers(groupName)
+	if err != nil {
+		return diag.Errorf("failed to list users of Aviatrix RBAC permission group: %s", err)
+	}
+	if err := d.Set("user_names", userNames); err != nil {
+		return diag.Errorf("failed to set user_names: %s", err)
+	}
+
+	d.SetId(groupName)
+	return nil
+}
+
+func resourceAviatrixRbacGroupMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if d.HasChange("user_names") {
+		if err := reconcileRbacGroupUsers(client, d); err != nil {
+			return diag.Errorf("failed to update Aviatrix RBAC group membership: %s", err)
+		}
+	}
+
+	return resourceAviatrixRbacGroupMembershipRead(ctx, d, meta)
+}
+
+func resourceAviatrixRbacGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	groupName := d.Get("group_name").(string)
+
+	log.Printf("[INFO] Deleting Aviatrix RBAC group membership: %s", groupName)
+
+	userNames := getStringSet(d, "user_names")
+	if len(userNames) == 0 {
+		return nil
+	}
+	if err := client.DetachRbacGroupUsers(groupName, userNames); err != nil {
+		return diag.Errorf("failed to delete Aviatrix RBAC group membership: %s", err)
+	}
+
+	return nil
+}
+
+// reconcileRbacGroupUsers adds and removes users so that the group has exactly the users in 'user_names'.
+func reconcileRbacGroupUsers(client *goaviatrix.Client, d *schema.ResourceData) error {
+	groupName := d.Get("group_name").(string)
+
+	current, err := client.ListRbacGroupUsers(groupName)
+	if err != nil {
+		return err
+	}
+
+	return reconcileRbacGroupList(groupName, "users", current, getStringSet(d, "user_names"),
+		client.AttachRbacGroupUsers, client.DetachRbacGroupUsers)
+}
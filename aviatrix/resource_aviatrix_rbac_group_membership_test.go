@@ -0,0 +1,100 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixRbacGroupMembership_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+
+	skipAcc := os.Getenv("SKIP_RBAC_GROUP_MEMBERSHIP")
+	if skipAcc == "yes" {
+		t.Skip("Skipping rbac group membership tests as SKIP_RBAC_GROUP_MEMBERSHIP is set")
+	}
+
+	resourceName := "aviatrix_rbac_group_membership.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRbacGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRbacGroupMembershipConfigBasic(rName, "aviatrix_account_user.test1.username, aviatrix_account_user.test2.username"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRbacGroupMembershipExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "group_name", fmt.Sprintf("tf-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "2"),
+				),
+			},
+			{
+				Config: testAccRbacGroupMembershipConfigBasic(rName, "aviatrix_account_user.test2.username"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRbacGroupMembershipExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_names.*", fmt.Sprintf("tf-user2-%s", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRbacGroupMembershipConfigBasic(rName string, userNames string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_rbac_group" "test" {
+	group_name = "tf-%[1]s"
+}
+resource "aviatrix_account_user" "test1" {
+	username = "tf-user1-%[1]s"
+	email    = "abc@xyz.com"
+	password = "Password-1234"
+}
+resource "aviatrix_account_user" "test2" {
+	username = "tf-user2-%[1]s"
+	email    = "abc@xyz.com"
+	password = "Password-1234"
+}
+resource "aviatrix_rbac_group_membership" "test" {
+	group_name = aviatrix_rbac_group.test.group_name
+	user_names = [%[2]s]
+}
+	`, rName, userNames)
+}
+
+func testAccCheckRbacGroupMembershipExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("RbacGroupMembership Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no RbacGroupMembership ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		userNames, err := client.ListRbacGroupUsers(rs.Primary.Attributes["group_name"])
+		if err != nil {
+			return err
+		}
+		if strconv.Itoa(len(userNames)) != rs.Primary.Attributes["user_names.#"] {
+			return fmt.Errorf("RBAC group has users %v, expected %s users", userNames, rs.Primary.Attributes["user_names.#"])
+		}
+		return nil
+	}
+}
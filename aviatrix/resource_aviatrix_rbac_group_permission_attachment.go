@@ -1,6 +1,7 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -19,6 +20,7 @@ func resourceAviatrixRbacGroupPermissionAttachment() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceAviatrixRbacGroupPermissionAttachmentCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"group_name": {
@@ -28,26 +30,11 @@ func resourceAviatrixRbacGroupPermissionAttachment() *schema.Resource {
 				Description: "RBAC permission group name.",
 			},
 			"permission_name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					"all_dashboard_write",
-					"all_accounts_write",
-					"all_gateway_write",
-					"all_tgw_orchestrator_write",
-					"all_transit_network_write",
-					"all_firewall_network_write",
-					"all_cloudn_write",
-					"all_peering_write",
-					"all_site2cloud_write",
-					"all_openvpn_write",
-					"all_security_write",
-					"all_useful_tools_write",
-					"all_troubleshoot_write",
-					"all_write",
-				}, false),
-				Description: "Permission name.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Permission name. Validated against the permissions available on the controller.",
 			},
 		},
 	}
@@ -139,3 +126,30 @@ func resourceAviatrixRbacGroupPermissionAttachmentDelete(d *schema.ResourceData,
 
 	return nil
 }
+
+func resourceAviatrixRbacGroupPermissionAttachmentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("permission_name") || !d.NewValueKnown("permission_name") {
+		return nil
+	}
+	client := meta.(*goaviatrix.Client)
+	return goaviatrix.ValidateRbacPermissionNames([]string{d.Get("permission_name").(string)}, rbacPermissionNames(client))
+}
+
+// rbacPermissionNames returns the names of the RBAC permissions available on the controller, or the
+// known permission names if the controller can't list them.
+func rbacPermissionNames(client *goaviatrix.Client) []string {
+	permissions, err := client.ListRbacPermissions()
+	if err != nil {
+		log.Printf("[WARN] Couldn't list the RBAC permissions of the controller, using the known permissions: %v", err)
+		return goaviatrix.KnownRbacPermissionNames
+	}
+	if len(permissions) == 0 {
+		return goaviatrix.KnownRbacPermissionNames
+	}
+
+	var names []string
+	for _, permission := range permissions {
+		names = append(names, permission.Name)
+	}
+	return names
+}
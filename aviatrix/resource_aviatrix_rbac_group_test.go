@@ -42,10 +42,36 @@ func TestAccAviatrixRbacGroup_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccRbacGroupConfigPermissions(rName, `"all_gateway_write", "all_peering_write"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRbacGroupExists(resourceName, &rbacGroup),
+					resource.TestCheckResourceAttr(resourceName, "manage_permissions", "true"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+				),
+			},
+			{
+				Config: testAccRbacGroupConfigPermissions(rName, `"all_peering_write"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRbacGroupExists(resourceName, &rbacGroup),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "all_peering_write"),
+				),
+			},
 		},
 	})
 }
 
+func testAccRbacGroupConfigPermissions(rName string, permissions string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_rbac_group" "test" {
+	group_name         = "tf-%s"
+	manage_permissions = true
+	permissions        = [%s]
+}
+	`, rName, permissions)
+}
+
 func testAccRbacGroupConfigBasic(rName string) string {
 	return fmt.Sprintf(`
 resource "aviatrix_rbac_group" "test" {
@@ -1,25 +1,29 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixSamlEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSamlEndpointCreate,
-		Read:   resourceAviatrixSamlEndpointRead,
-		Delete: resourceAviatrixSamlEndpointDelete,
-		Update: resourceAviatrixSamlEndpointUpdate,
+		CreateWithoutTimeout: resourceAviatrixSamlEndpointCreate,
+		ReadWithoutTimeout:   resourceAviatrixSamlEndpointRead,
+		DeleteWithoutTimeout: resourceAviatrixSamlEndpointDelete,
+		UpdateWithoutTimeout: resourceAviatrixSamlEndpointUpdate,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceAviatrixSamlEndpointCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"endpoint_name": {
@@ -90,39 +94,102 @@ func resourceAviatrixSamlEndpoint() *schema.Resource {
 				Default:     false,
 				Description: "Whether to sign SAML AuthnRequests",
 			},
+			"refresh_idp_metadata": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Fetch the IDP Metadata from 'idp_metadata_url' during every plan and update the " +
+					"SAML endpoint when it changed, e.g. after an IdP certificate rollover.",
+			},
+			"idp_cert_expiry_warning_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Warn when an IdP signing certificate expires within this number of days.",
+			},
+			"idp_entity_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Entity ID of the IdP, from the IDP Metadata.",
+			},
+			"idp_sso_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Single sign-on URL of the IdP, from the IDP Metadata.",
+			},
+			"idp_signing_certificates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Signing certificates of the IdP, from the IDP Metadata.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subject": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Subject of the certificate.",
+						},
+						"not_before": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Start of the validity of the certificate, in RFC 3339 format.",
+						},
+						"not_after": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Expiry of the certificate, in RFC 3339 format.",
+						},
+						"sha256_fingerprint": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "SHA-256 fingerprint of the certificate.",
+						},
+					},
+				},
+			},
+			"idp_metadata_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 hash of the IDP Metadata last applied to the SAML endpoint.",
+			},
 		},
 	}
 }
 
-func resourceAviatrixSamlEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixSamlEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 
 	samlEndpoint, err := GetAviatrixSamlEndpointInput(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	metadata, diags := loadSamlEndpointIdpMetadata(ctx, d)
+	if diags.HasError() {
+		return diags
 	}
 
 	d.SetId(samlEndpoint.EndPointName)
 	flag := false
-	defer resourceAviatrixSamlEndpointReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixSamlEndpointReadIfRequired(ctx, d, meta, &flag)
 
 	err = client.CreateSamlEndpoint(samlEndpoint)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix SAML endpoint: %s", err)
+		return append(diags, diag.Errorf("failed to create Aviatrix SAML endpoint: %s", err)...)
 	}
+	setSamlEndpointIdpMetadata(d, metadata)
 
-	return resourceAviatrixSamlEndpointReadIfRequired(d, meta, &flag)
+	return append(diags, resourceAviatrixSamlEndpointReadIfRequired(ctx, d, meta, &flag)...)
 }
 
-func resourceAviatrixSamlEndpointReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixSamlEndpointReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSamlEndpointRead(d, meta)
+		return resourceAviatrixSamlEndpointRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSamlEndpointRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixSamlEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 
 	endpointName := d.Get("endpoint_name").(string)
@@ -131,6 +198,8 @@ func resourceAviatrixSamlEndpointRead(d *schema.ResourceData, meta interface{})
 		id := d.Id()
 		log.Printf("[DEBUG] Looks like an import, no SAML endpoint names received. Import Id is %s", id)
 		d.Set("endpoint_name", id)
+		d.Set("refresh_idp_metadata", false)
+		d.Set("idp_cert_expiry_warning_days", 30)
 		d.SetId(id)
 	}
 
@@ -144,7 +213,7 @@ func resourceAviatrixSamlEndpointRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix SAML Endpoint: %s", err)
+		return diag.Errorf("couldn't find Aviatrix SAML Endpoint: %s", err)
 	}
 
 	log.Printf("[INFO] Found Aviatrix SAML Endpoint: %#v", saml)
@@ -186,27 +255,43 @@ func resourceAviatrixSamlEndpointRead(d *schema.ResourceData, meta interface{})
 		d.Set("rbac_groups", []string{})
 	}
 
+	// The metadata fetched from a URL is only known from the last create, update or refresh
+	if saml.IdpMetadataType != "URL" && d.Get("idp_metadata_sha256").(string) == "" && saml.IdpMetadata != "" {
+		if info, err := goaviatrix.ParseIdpMetadata([]byte(saml.IdpMetadata)); err == nil {
+			setSamlEndpointIdpMetadata(d, &samlEndpointIdpMetadata{raw: []byte(saml.IdpMetadata), info: info})
+		} else {
+			log.Printf("[WARN] Couldn't parse the IDP Metadata of SAML endpoint %s: %v", saml.EndPointName, err)
+		}
+	}
+
 	d.SetId(saml.EndPointName)
-	return nil
+	return samlEndpointCertExpiryWarnings(d)
 }
 
-func resourceAviatrixSamlEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixSamlEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 
 	samlEndpoint, err := GetAviatrixSamlEndpointInput(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
+	metadata, diags := loadSamlEndpointIdpMetadata(ctx, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	// An update with an unchanged idp_metadata_url makes the controller fetch the IDP Metadata again
 	err = client.EditSamlEndpoint(samlEndpoint)
 	if err != nil {
-		return fmt.Errorf("failed to edit Aviatrix SAML endpoint: %s", err)
+		return append(diags, diag.Errorf("failed to edit Aviatrix SAML endpoint: %s", err)...)
 	}
+	setSamlEndpointIdpMetadata(d, metadata)
 
 	d.SetId(samlEndpoint.EndPointName)
-	return resourceAviatrixSamlEndpointRead(d, meta)
+	return append(diags, resourceAviatrixSamlEndpointRead(ctx, d, meta)...)
 }
 
-func resourceAviatrixSamlEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixSamlEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 
 	samlEndpoint := &goaviatrix.SamlEndpoint{
@@ -219,7 +304,7 @@ func resourceAviatrixSamlEndpointDelete(d *schema.ResourceData, meta interface{}
 
 	err := client.DeleteSamlEndpoint(samlEndpoint)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix SAML Endpoint: %s", err)
+		return diag.Errorf("failed to delete Aviatrix SAML Endpoint: %s", err)
 	}
 
 	return nil
@@ -271,3 +356,157 @@ func GetAviatrixSamlEndpointInput(d *schema.ResourceData) (*goaviatrix.SamlEndpo
 	}
 	return samlEndpoint, nil
 }
+
+type samlEndpointIdpMetadata struct {
+	raw  []byte
+	info *goaviatrix.IdpMetadata
+}
+
+// loadSamlEndpointIdpMetadata parses the IDP Metadata of the SAML endpoint, fetching it for the 'URL' type.
+// Failing to fetch the metadata is only a warning since the controller fetches it on its own.
+func loadSamlEndpointIdpMetadata(ctx context.Context, d *schema.ResourceData) (*samlEndpointIdpMetadata, diag.Diagnostics) {
+	var raw []byte
+	if d.Get("idp_metadata_type").(string) == "URL" {
+		url := d.Get("idp_metadata_url").(string)
+		var err error
+		raw, err = goaviatrix.FetchIdpMetadata(ctx, url)
+		if err != nil {
+			return nil, diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "Couldn't fetch the IDP Metadata of the SAML endpoint",
+				Detail:   fmt.Sprintf("Fetching %s failed, so the IdP attributes aren't known: %v", url, err),
+			}}
+		}
+	} else {
+		raw = []byte(d.Get("idp_metadata").(string))
+	}
+
+	info, err := goaviatrix.ParseIdpMetadata(raw)
+	if err != nil {
+		return nil, diag.Errorf("invalid IDP Metadata: %s", err)
+	}
+	return &samlEndpointIdpMetadata{raw: raw, info: info}, nil
+}
+
+func setSamlEndpointIdpMetadata(d *schema.ResourceData, metadata *samlEndpointIdpMetadata) {
+	if metadata == nil {
+		d.Set("idp_entity_id", "")
+		d.Set("idp_sso_url", "")
+		d.Set("idp_signing_certificates", nil)
+		d.Set("idp_metadata_sha256", "")
+		return
+	}
+	d.Set("idp_entity_id", metadata.info.EntityID)
+	d.Set("idp_sso_url", metadata.info.SSOURL)
+	if err := d.Set("idp_signing_certificates", flattenIdpSigningCerts(metadata.info.SigningCerts)); err != nil {
+		log.Printf("[WARN] Error setting 'idp_signing_certificates' for (%s): %s", d.Id(), err)
+	}
+	d.Set("idp_metadata_sha256", goaviatrix.IdpMetadataSHA256(metadata.raw))
+}
+
+func flattenIdpSigningCerts(certs []goaviatrix.IdpSigningCert) []interface{} {
+	var result []interface{}
+	for _, cert := range certs {
+		result = append(result, map[string]interface{}{
+			"subject":            cert.Subject,
+			"not_before":         cert.NotBefore.Format(time.RFC3339),
+			"not_after":          cert.NotAfter.Format(time.RFC3339),
+			"sha256_fingerprint": cert.SHA256Fingerprint,
+		})
+	}
+	return result
+}
+
+func samlEndpointCertExpiryWarnings(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	now := time.Now()
+	deadline := now.AddDate(0, 0, d.Get("idp_cert_expiry_warning_days").(int))
+	for _, v := range d.Get("idp_signing_certificates").([]interface{}) {
+		cert := v.(map[string]interface{})
+		notAfter, err := time.Parse(time.RFC3339, cert["not_after"].(string))
+		if err != nil || !notAfter.Before(deadline) {
+			continue
+		}
+		expires := "expires"
+		if notAfter.Before(now) {
+			expires = "expired"
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("SAML endpoint IdP signing certificate %s", expires),
+			Detail: fmt.Sprintf("The IdP signing certificate %s (SHA-256 fingerprint %s) of SAML endpoint %s %s on %s.",
+				cert["subject"], cert["sha256_fingerprint"], d.Get("endpoint_name").(string), expires, cert["not_after"]),
+		})
+	}
+	return diags
+}
+
+func resourceAviatrixSamlEndpointCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	computed := []string{"idp_entity_id", "idp_sso_url", "idp_signing_certificates", "idp_metadata_sha256"}
+	setNewComputed := func() error {
+		for _, k := range computed {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var raw []byte
+	if d.Get("idp_metadata_type").(string) == "URL" {
+		changed := d.HasChanges("idp_metadata_type", "idp_metadata_url")
+		if !d.NewValueKnown("idp_metadata_url") {
+			return setNewComputed()
+		}
+		if !changed && !d.Get("refresh_idp_metadata").(bool) {
+			return nil
+		}
+		url := d.Get("idp_metadata_url").(string)
+		if url == "" {
+			return nil
+		}
+		var err error
+		raw, err = goaviatrix.FetchIdpMetadata(ctx, url)
+		if err != nil {
+			log.Printf("[WARN] Couldn't fetch the IDP Metadata from %s: %v", url, err)
+			if changed {
+				return setNewComputed()
+			}
+			return nil
+		}
+	} else {
+		if !d.NewValueKnown("idp_metadata") {
+			return setNewComputed()
+		}
+		if !d.HasChanges("idp_metadata_type", "idp_metadata") || d.Get("idp_metadata").(string) == "" {
+			return nil
+		}
+		raw = []byte(d.Get("idp_metadata").(string))
+	}
+
+	info, err := goaviatrix.ParseIdpMetadata(raw)
+	if err != nil {
+		return fmt.Errorf("invalid IDP Metadata: %v", err)
+	}
+	hash := goaviatrix.IdpMetadataSHA256(raw)
+	if hash == d.Get("idp_metadata_sha256").(string) {
+		return nil
+	}
+
+	deadline := time.Now().AddDate(0, 0, d.Get("idp_cert_expiry_warning_days").(int))
+	for _, cert := range info.SigningCertsExpiringBefore(deadline) {
+		log.Printf("[WARN] IdP signing certificate %s of SAML endpoint %s expires on %s", cert.Subject,
+			d.Get("endpoint_name").(string), cert.NotAfter.Format(time.RFC3339))
+	}
+
+	if err := d.SetNew("idp_entity_id", info.EntityID); err != nil {
+		return err
+	}
+	if err := d.SetNew("idp_sso_url", info.SSOURL); err != nil {
+		return err
+	}
+	if err := d.SetNew("idp_signing_certificates", flattenIdpSigningCerts(info.SigningCerts)); err != nil {
+		return err
+	}
+	return d.SetNew("idp_metadata_sha256", hash)
+}
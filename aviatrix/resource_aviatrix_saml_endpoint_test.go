@@ -52,12 +52,17 @@ func TestAccAviatrixSamlEndpoint_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "endpoint_name", rName),
 					resource.TestCheckResourceAttr(resourceName, "idp_metadata", idpMetadata),
 					resource.TestCheckResourceAttr(resourceName, "idp_metadata_type", idpMetadataType),
+					resource.TestCheckResourceAttrSet(resourceName, "idp_entity_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "idp_sso_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "idp_signing_certificates.0.not_after"),
 				),
 			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				// The controller may reformat the IDP Metadata
+				ImportStateVerifyIgnore: []string{"idp_metadata_sha256"},
 			},
 		},
 	})
@@ -515,6 +515,14 @@ func resourceAviatrixSpokeGateway() *schema.Resource {
 				Description: "ha_image_version can be used to set the desired image version of the HA gateway. " +
 					"If set, we will attempt to update the gateway to the specified version.",
 			},
+			"image_upgrade_health_check_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultGatewayImageUpgradeHealthTimeout,
+				ValidateFunc: validation.IntAtLeast(60),
+				Description: "Seconds to wait for the HA gateway to become healthy after its image upgrade, " +
+					"before the image of the primary gateway is upgraded.",
+			},
 			"security_group_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -1331,6 +1339,7 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
 		d.Set("gw_name", id)
 		d.Set("manage_transit_gateway_attachment", true)
+		d.Set("image_upgrade_health_check_timeout", defaultGatewayImageUpgradeHealthTimeout)
 		d.SetId(id)
 	}
 
@@ -2520,7 +2529,30 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			} else if haErr != nil {
 				return fmt.Errorf("could not upgrade HA spoke gateway ha_software_version=%s: %v", haSwVersion, haErr)
 			}
-		} else { // Only primary or only HA has changed, or image_version changed, or it is a software rollback
+		} else if (primaryHasImageVersionChange || haHasImageVersionChange) &&
+			!primaryRollbackSoftwareVersion && !haRollbackSoftwareVersion {
+			// The gateways are rebuilt on the new image, so the HA gateway is rebuilt first and the
+			// primary gateway only once the HA gateway is healthy and can take over its traffic.
+			log.Printf("[INFO] Upgrading spoke gateway gw_name=%s image with a rolling rebuild, HA gateway first", gateway.GwName)
+			var gw, hagw *goaviatrix.Gateway
+			if primaryHasVersionChange {
+				gw = &goaviatrix.Gateway{
+					GwName:          gateway.GwName,
+					SoftwareVersion: d.Get("software_version").(string),
+					ImageVersion:    d.Get("image_version").(string),
+				}
+			}
+			if haHasVersionChange {
+				hagw = &goaviatrix.Gateway{
+					GwName:          gateway.GwName + "-hagw",
+					SoftwareVersion: d.Get("ha_software_version").(string),
+					ImageVersion:    d.Get("ha_image_version").(string),
+				}
+			}
+			if err := rollingGatewayImageUpgrade(context.Background(), client, "spoke", gw, hagw, time.Duration(d.Get("image_upgrade_health_check_timeout").(int))*time.Second); err != nil {
+				return err
+			}
+		} else { // Only primary or only HA has changed, or it is a software rollback
 			log.Printf("[INFO] Upgrading spoke gateway gw_name=%s ha or primary in serial", gateway.GwName)
 			if primaryHasVersionChange {
 				swVersion := d.Get("software_version").(string)
@@ -356,6 +356,15 @@ func resourceAviatrixTransitExternalDeviceConn() *schema.Resource {
 				Computed:    true,
 				Description: "Set of approved cidrs. Requires 'enable_learned_cidrs_approval' to be true. Type: Set(String).",
 			},
+			"manage_bgp_connection_policy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "This parameter is a switch used to determine whether or not to manage the BGP policy of this connection " +
+					"using the aviatrix_transit_external_device_conn resource. If this is set to false, 'prepend_as_path', " +
+					"'manual_bgp_advertised_cidrs', 'enable_learned_cidrs_approval' and 'approved_cidrs' must be managed using " +
+					"the aviatrix_bgp_connection_policy resource. Valid values: true, false. Default value: true.",
+			},
 			"local_lan_ip": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -559,6 +568,12 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 		return fmt.Errorf("creating transit external device conn: 'approved_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
+	if !d.Get("manage_bgp_connection_policy").(bool) {
+		if err := checkTransitExternalDeviceConnUnmanagedBgpConnectionPolicy(d); err != nil {
+			return err
+		}
+	}
+
 	enableIkev2 := d.Get("enable_ikev2").(bool)
 	if enableIkev2 {
 		externalDeviceConn.EnableIkev2 = "true"
@@ -775,6 +790,7 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 		}
 		d.Set("connection_name", parts[0])
 		d.Set("vpc_id", parts[1])
+		d.Set("manage_bgp_connection_policy", true)
 		d.SetId(id)
 	}
 
@@ -883,18 +899,23 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 		}
 		d.Set("switch_to_ha_standby_gateway", activeGatewayType == "HA")
 
-		for _, v := range transitAdvancedConfig.ConnectionLearnedCIDRApprovalInfo {
-			if v.ConnName == externalDeviceConn.ConnectionName {
-				d.Set("enable_learned_cidrs_approval", v.EnabledApproval == "yes")
-				err := d.Set("approved_cidrs", v.ApprovedLearnedCidrs)
-				if err != nil {
-					return fmt.Errorf("could not set 'approved_cidrs' in state: %v", err)
+		manageBgpConnectionPolicy := d.Get("manage_bgp_connection_policy").(bool)
+		if manageBgpConnectionPolicy {
+			for _, v := range transitAdvancedConfig.ConnectionLearnedCIDRApprovalInfo {
+				if v.ConnName == externalDeviceConn.ConnectionName {
+					d.Set("enable_learned_cidrs_approval", v.EnabledApproval == "yes")
+					err := d.Set("approved_cidrs", v.ApprovedLearnedCidrs)
+					if err != nil {
+						return fmt.Errorf("could not set 'approved_cidrs' in state: %v", err)
+					}
+					break
 				}
-				break
 			}
-		}
-		if len(transitAdvancedConfig.ConnectionLearnedCIDRApprovalInfo) == 0 {
-			d.Set("enable_learned_cidrs_approval", false)
+			if len(transitAdvancedConfig.ConnectionLearnedCIDRApprovalInfo) == 0 {
+				d.Set("enable_learned_cidrs_approval", false)
+				d.Set("approved_cidrs", nil)
+			}
+		} else {
 			d.Set("approved_cidrs", nil)
 		}
 
@@ -904,8 +925,10 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 			d.Set("enable_ikev2", false)
 		}
 
-		if err := d.Set("manual_bgp_advertised_cidrs", conn.ManualBGPCidrs); err != nil {
-			return fmt.Errorf("setting 'manual_bgp_advertised_cidrs' into state: %v", err)
+		if manageBgpConnectionPolicy {
+			if err := d.Set("manual_bgp_advertised_cidrs", conn.ManualBGPCidrs); err != nil {
+				return fmt.Errorf("setting 'manual_bgp_advertised_cidrs' into state: %v", err)
+			}
 		}
 		if conn.TunnelProtocol == "" {
 			d.Set("tunnel_protocol", "IPsec")
@@ -925,7 +948,7 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 			d.Set("phase1_remote_identifier", ph1RemoteId)
 		}
 
-		if conn.PrependAsPath != "" {
+		if conn.PrependAsPath != "" && manageBgpConnectionPolicy {
 			var prependAsPath []string
 			for _, str := range strings.Split(conn.PrependAsPath, " ") {
 				prependAsPath = append(prependAsPath, strings.TrimSpace(str))
@@ -948,9 +971,15 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 
 	approvedCidrs := getStringSet(d, "approved_cidrs")
 	enableLearnedCIDRApproval := d.Get("enable_learned_cidrs_approval").(bool)
-	if !enableLearnedCIDRApproval && len(approvedCidrs) > 0 {
+	manageBgpConnectionPolicy := d.Get("manage_bgp_connection_policy").(bool)
+	if manageBgpConnectionPolicy && !enableLearnedCIDRApproval && len(approvedCidrs) > 0 {
 		return fmt.Errorf("updating transit external device conn: 'approved_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
+	if !manageBgpConnectionPolicy {
+		if err := checkTransitExternalDeviceConnUnmanagedBgpConnectionPolicy(d); err != nil {
+			return err
+		}
+	}
 
 	gwName := d.Get("gw_name").(string)
 	connName := d.Get("connection_name").(string)
@@ -969,7 +998,7 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 		}
 	}
 
-	if d.HasChange("enable_learned_cidrs_approval") {
+	if d.HasChange("enable_learned_cidrs_approval") && manageBgpConnectionPolicy {
 		enableLearnedCIDRApproval := d.Get("enable_learned_cidrs_approval").(bool)
 		if enableLearnedCIDRApproval {
 			err = client.EnableTransitConnectionLearnedCIDRApproval(gwName, connName)
@@ -984,7 +1013,7 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 		}
 	}
 
-	if d.HasChange("manual_bgp_advertised_cidrs") {
+	if d.HasChange("manual_bgp_advertised_cidrs") && manageBgpConnectionPolicy {
 		manualBGPCidrs := getStringSet(d, "manual_bgp_advertised_cidrs")
 		err := client.EditTransitConnectionBGPManualAdvertiseCIDRs(gwName, connName, manualBGPCidrs)
 		if err != nil {
@@ -1030,7 +1059,7 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 		}
 	}
 
-	if d.HasChange("approved_cidrs") {
+	if d.HasChange("approved_cidrs") && manageBgpConnectionPolicy {
 		err := client.UpdateTransitConnectionPendingApprovedCidrs(gwName, connName, approvedCidrs)
 		if err != nil {
 			return fmt.Errorf("could not update transit external device conn learned cidrs during update: %v", err)
@@ -1067,7 +1096,7 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 		}
 	}
 
-	if d.HasChange("prepend_as_path") {
+	if d.HasChange("prepend_as_path") && manageBgpConnectionPolicy {
 		externalDeviceConn := &goaviatrix.ExternalDeviceConn{
 			ConnectionName: connName,
 			GwName:         gwName,
@@ -1187,3 +1216,15 @@ func resourceAviatrixTransitExternalDeviceConnDelete(d *schema.ResourceData, met
 
 	return nil
 }
+
+// checkTransitExternalDeviceConnUnmanagedBgpConnectionPolicy checks that the BGP policy attributes of a connection with
+// 'manage_bgp_connection_policy' set to false are left empty, since they are managed by aviatrix_bgp_connection_policy.
+func checkTransitExternalDeviceConnUnmanagedBgpConnectionPolicy(d *schema.ResourceData) error {
+	if d.Get("enable_learned_cidrs_approval").(bool) ||
+		len(getStringSet(d, "manual_bgp_advertised_cidrs")) != 0 ||
+		len(d.Get("prepend_as_path").([]interface{})) != 0 {
+		return fmt.Errorf("'manage_bgp_connection_policy' is set to false. Please set it to true, or use 'aviatrix_bgp_connection_policy' " +
+			"to manage 'prepend_as_path', 'manual_bgp_advertised_cidrs', 'enable_learned_cidrs_approval' and 'approved_cidrs'")
+	}
+	return nil
+}
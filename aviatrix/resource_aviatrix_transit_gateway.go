@@ -460,6 +460,15 @@ func resourceAviatrixTransitGateway() *schema.Resource {
 				ValidateFunc: validation.IntBetween(12, 360),
 				Description:  "BGP Hold Time.",
 			},
+			"manage_bgp_config": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "This parameter is a switch used to determine whether or not to manage the BGP configuration of this transit gateway " +
+					"using the aviatrix_transit_gateway resource. If this is set to false, 'local_as_number', 'prepend_as_path', 'bgp_ecmp', " +
+					"'bgp_polling_time' and 'bgp_hold_time' must be managed using the aviatrix_transit_gateway_bgp_config resource. " +
+					"Valid values: true, false. Default value: true.",
+			},
 			"enable_transit_summarize_cidr_to_tgw": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -613,6 +622,14 @@ func resourceAviatrixTransitGateway() *schema.Resource {
 				Description: "ha_image_version can be used to set the desired image version of the HA gateway. " +
 					"If set, we will attempt to update the gateway to the specified version.",
 			},
+			"image_upgrade_health_check_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultGatewayImageUpgradeHealthTimeout,
+				ValidateFunc: validation.IntAtLeast(60),
+				Description: "Seconds to wait for the HA gateway to become healthy after its image upgrade, " +
+					"before the image of the primary gateway is upgraded.",
+			},
 			"security_group_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -900,6 +917,16 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		return fmt.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
+	manageBgpConfig := d.Get("manage_bgp_config").(bool)
+	if !manageBgpConfig {
+		if _, ok := d.GetOk("local_as_number"); ok {
+			return fmt.Errorf("'manage_bgp_config' is set to false. Please set it to true, or use 'aviatrix_transit_gateway_bgp_config' to set 'local_as_number'")
+		}
+		if err := checkTransitGatewayUnmanagedBgpConfig(d); err != nil {
+			return err
+		}
+	}
+
 	enableMonitorSubnets := d.Get("enable_monitor_gateway_subnets").(bool)
 	var excludedInstances []string
 	for _, v := range d.Get("monitor_exclude_list").(*schema.Set).List() {
@@ -1414,21 +1441,21 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if val, ok := d.GetOk("bgp_polling_time"); ok {
+	if val, ok := d.GetOk("bgp_polling_time"); ok && manageBgpConfig {
 		err := client.SetBgpPollingTime(gateway, val.(string))
 		if err != nil {
 			return fmt.Errorf("could not set bgp polling time: %v", err)
 		}
 	}
 
-	if val, ok := d.GetOk("local_as_number"); ok {
+	if val, ok := d.GetOk("local_as_number"); ok && manageBgpConfig {
 		err := client.SetLocalASNumber(gateway, val.(string))
 		if err != nil {
 			return fmt.Errorf("could not set local_as_number: %v", err)
 		}
 	}
 
-	if val, ok := d.GetOk("prepend_as_path"); ok {
+	if val, ok := d.GetOk("prepend_as_path"); ok && manageBgpConfig {
 		var prependASPath []string
 		slice := val.([]interface{})
 		for _, v := range slice {
@@ -1440,7 +1467,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if val, ok := d.GetOk("bgp_ecmp"); ok {
+	if val, ok := d.GetOk("bgp_ecmp"); ok && manageBgpConfig {
 		err := client.SetBgpEcmp(gateway, val.(bool))
 		if err != nil {
 			return fmt.Errorf("could not set bgp_ecmp: %v", err)
@@ -1523,7 +1550,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if holdTime := d.Get("bgp_hold_time").(int); holdTime != defaultBgpHoldTime {
+	if holdTime := d.Get("bgp_hold_time").(int); holdTime != defaultBgpHoldTime && manageBgpConfig {
 		err := client.ChangeBgpHoldTime(gateway.GwName, holdTime)
 		if err != nil {
 			return fmt.Errorf("could not change BGP Hold Time after Transit Gateway creation: %v", err)
@@ -1592,6 +1619,8 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 		id := d.Id()
 		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
 		d.Set("gw_name", id)
+		d.Set("manage_bgp_config", true)
+		d.Set("image_upgrade_health_check_timeout", defaultGatewayImageUpgradeHealthTimeout)
 		gwName = id
 		d.SetId(id)
 	}
@@ -1627,24 +1656,26 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 	d.Set("single_az_ha", gw.SingleAZ == "yes")
 	d.Set("enable_hybrid_connection", goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes) && gw.EnableHybridConnection)
 	d.Set("connected_transit", gw.ConnectedTransit == "yes")
-	d.Set("bgp_hold_time", gw.BgpHoldTime)
-	d.Set("bgp_polling_time", strconv.Itoa(gw.BgpPollingTime))
 	d.Set("image_version", gw.ImageVersion)
 	d.Set("software_version", gw.SoftwareVersion)
 	d.Set("rx_queue_size", gw.RxQueueSize)
 
-	var prependAsPath []string
-	for _, p := range strings.Split(gw.PrependASPath, " ") {
-		if p != "" {
-			prependAsPath = append(prependAsPath, p)
+	d.Set("local_as_number", gw.LocalASNumber)
+	if d.Get("manage_bgp_config").(bool) {
+		d.Set("bgp_hold_time", gw.BgpHoldTime)
+		d.Set("bgp_polling_time", strconv.Itoa(gw.BgpPollingTime))
+		var prependAsPath []string
+		for _, p := range strings.Split(gw.PrependASPath, " ") {
+			if p != "" {
+				prependAsPath = append(prependAsPath, p)
+			}
 		}
+		err = d.Set("prepend_as_path", prependAsPath)
+		if err != nil {
+			return fmt.Errorf("could not set prepend_as_path: %v", err)
+		}
+		d.Set("bgp_ecmp", gw.BgpEcmp)
 	}
-	err = d.Set("prepend_as_path", prependAsPath)
-	if err != nil {
-		return fmt.Errorf("could not set prepend_as_path: %v", err)
-	}
-	d.Set("local_as_number", gw.LocalASNumber)
-	d.Set("bgp_ecmp", gw.BgpEcmp)
 	d.Set("enable_active_standby", gw.EnableActiveStandby)
 	d.Set("enable_active_standby_preemptive", gw.EnableActiveStandbyPreemptive)
 	d.Set("enable_s2c_rx_balancing", gw.EnableS2CRxBalancing)
@@ -2112,6 +2143,16 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		return fmt.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
+	manageBgpConfig := d.Get("manage_bgp_config").(bool)
+	if !manageBgpConfig {
+		if d.HasChange("local_as_number") {
+			return fmt.Errorf("'manage_bgp_config' is set to false. Please set it to true, or use 'aviatrix_transit_gateway_bgp_config' to update 'local_as_number'")
+		}
+		if err := checkTransitGatewayUnmanagedBgpConfig(d); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("enable_private_oob") {
 		return fmt.Errorf("updating enable_private_oob is not allowed")
 	}
@@ -2978,7 +3019,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChange("bgp_polling_time") {
+	if d.HasChange("bgp_polling_time") && manageBgpConfig {
 		bgpPollingTime := d.Get("bgp_polling_time").(string)
 		gateway := &goaviatrix.TransitVpc{
 			GwName: d.Get("gw_name").(string),
@@ -2989,7 +3030,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChanges("local_as_number", "prepend_as_path") {
+	if d.HasChanges("local_as_number", "prepend_as_path") && manageBgpConfig {
 		var prependASPath []string
 		for _, v := range d.Get("prepend_as_path").([]interface{}) {
 			prependASPath = append(prependASPath, v.(string))
@@ -3023,7 +3064,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChange("bgp_ecmp") {
+	if d.HasChange("bgp_ecmp") && manageBgpConfig {
 		enabled := d.Get("bgp_ecmp").(bool)
 		gateway := &goaviatrix.TransitVpc{
 			GwName: d.Get("gw_name").(string),
@@ -3131,7 +3172,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChange("bgp_hold_time") {
+	if d.HasChange("bgp_hold_time") && manageBgpConfig {
 		err := client.ChangeBgpHoldTime(gateway.GwName, d.Get("bgp_hold_time").(int))
 		if err != nil {
 			return fmt.Errorf("could not change BGP Hold Time during Transit Gateway update: %v", err)
@@ -3259,7 +3300,30 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			} else if haErr != nil {
 				return fmt.Errorf("could not upgrade HA transit gateway ha_software_version=%s: %v", haSwVersion, haErr)
 			}
-		} else { // Only primary or only HA has changed, or image_version changed, or it is a software rollback
+		} else if (primaryHasImageVersionChange || haHasImageVersionChange) &&
+			!primaryRollbackSoftwareVersion && !haRollbackSoftwareVersion {
+			// The gateways are rebuilt on the new image, so the HA gateway is rebuilt first and the
+			// primary gateway only once the HA gateway is healthy and can take over its traffic.
+			log.Printf("[INFO] Upgrading transit gateway gw_name=%s image with a rolling rebuild, HA gateway first", gateway.GwName)
+			var gw, hagw *goaviatrix.Gateway
+			if primaryHasVersionChange {
+				gw = &goaviatrix.Gateway{
+					GwName:          gateway.GwName,
+					SoftwareVersion: d.Get("software_version").(string),
+					ImageVersion:    d.Get("image_version").(string),
+				}
+			}
+			if haHasVersionChange {
+				hagw = &goaviatrix.Gateway{
+					GwName:          gateway.GwName + "-hagw",
+					SoftwareVersion: d.Get("ha_software_version").(string),
+					ImageVersion:    d.Get("ha_image_version").(string),
+				}
+			}
+			if err := rollingGatewayImageUpgrade(context.Background(), client, "transit", gw, hagw, time.Duration(d.Get("image_upgrade_health_check_timeout").(int))*time.Second); err != nil {
+				return err
+			}
+		} else { // Only primary or only HA has changed, or it is a software rollback
 			log.Printf("[INFO] Upgrading transit gateway gw_name=%s ha or primary in serial", gateway.GwName)
 			if primaryHasVersionChange {
 				swVersion := d.Get("software_version").(string)
@@ -3401,3 +3465,16 @@ func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta interface
 
 	return nil
 }
+
+// checkTransitGatewayUnmanagedBgpConfig checks that the BGP settings of a transit gateway with 'manage_bgp_config'
+// set to false are left to their default values, since they are managed by aviatrix_transit_gateway_bgp_config.
+func checkTransitGatewayUnmanagedBgpConfig(d *schema.ResourceData) error {
+	if d.Get("bgp_polling_time").(string) != strconv.Itoa(goaviatrix.DefaultBgpPollingTime) ||
+		len(d.Get("prepend_as_path").([]interface{})) != 0 ||
+		d.Get("bgp_ecmp").(bool) ||
+		d.Get("bgp_hold_time").(int) != defaultBgpHoldTime {
+		return fmt.Errorf("'manage_bgp_config' is set to false. Please set it to true, or use 'aviatrix_transit_gateway_bgp_config' " +
+			"to manage 'prepend_as_path', 'bgp_ecmp', 'bgp_polling_time' and 'bgp_hold_time'")
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package aviatrix
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixTransitGatewayBgpConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixTransitGatewayBgpConfigCreate,
+		ReadWithoutTimeout:   resourceAviatrixTransitGatewayBgpConfigRead,
+		UpdateWithoutTimeout: resourceAviatrixTransitGatewayBgpConfigUpdate,
+		DeleteWithoutTimeout: resourceAviatrixTransitGatewayBgpConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"gw_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the transit gateway. Its 'manage_bgp_config' attribute must be set to false.",
+			},
+			"local_as_number": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: goaviatrix.ValidateASN,
+				Description:  "Local AS number of the transit gateway.",
+			},
+			"prepend_as_path": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				RequiredWith: []string{"local_as_number"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: goaviatrix.ValidateASN,
+				},
+				Description: "List of AS numbers to prepend to the AS_PATH of the routes advertised by the transit gateway.",
+			},
+			"bgp_ecmp": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable Equal Cost Multi Path (ECMP) routing for the next hop. Default value: false.",
+			},
+			"bgp_polling_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      goaviatrix.DefaultBgpPollingTime,
+				ValidateFunc: validation.IntBetween(10, 50),
+				Description:  "BGP route polling time in seconds. Valid values are between 10 and 50. Default value: 50.",
+			},
+			"bgp_hold_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      goaviatrix.DefaultBgpHoldTime,
+				ValidateFunc: validation.IntBetween(12, 360),
+				Description:  "BGP hold time in seconds. Valid values are between 12 and 360. Default value: 180.",
+			},
+		},
+	}
+}
+
+func marshalTransitGatewayBgpConfigInput(d *schema.ResourceData) *goaviatrix.TransitGatewayBgpConfig {
+	return &goaviatrix.TransitGatewayBgpConfig{
+		GwName:         d.Get("gw_name").(string),
+		LocalASNumber:  d.Get("local_as_number").(string),
+		PrependASPath:  getStringList(d, "prepend_as_path"),
+		BgpEcmp:        d.Get("bgp_ecmp").(bool),
+		BgpPollingTime: d.Get("bgp_polling_time").(int),
+		BgpHoldTime:    d.Get("bgp_hold_time").(int),
+	}
+}
+
+func resourceAviatrixTransitGatewayBgpConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	config := marshalTransitGatewayBgpConfigInput(d)
+	gateway := &goaviatrix.TransitVpc{GwName: config.GwName}
+
+	d.SetId(config.GwName)
+	flag := false
+	defer resourceAviatrixTransitGatewayBgpConfigReadIfRequired(ctx, d, meta, &flag)
+
+	if config.LocalASNumber != "" {
+		if err := client.SetLocalASNumber(gateway, config.LocalASNumber); err != nil {
+			return diag.Errorf("failed to set local AS number of transit gateway %s: %v", config.GwName, err)
+		}
+	}
+	if len(config.PrependASPath) != 0 {
+		if err := client.SetPrependASPath(gateway, config.PrependASPath); err != nil {
+			return diag.Errorf("failed to set prepend AS path of transit gateway %s: %v", config.GwName, err)
+		}
+	}
+	if err := client.SetBgpEcmp(gateway, config.BgpEcmp); err != nil {
+		return diag.Errorf("failed to set BGP ECMP of transit gateway %s: %v", config.GwName, err)
+	}
+	if err := client.SetBgpPollingTime(gateway, strconv.Itoa(config.BgpPollingTime)); err != nil {
+		return diag.Errorf("failed to set BGP polling time of transit gateway %s: %v", config.GwName, err)
+	}
+	if err := client.ChangeBgpHoldTime(config.GwName, config.BgpHoldTime); err != nil {
+		return diag.Errorf("failed to set BGP hold time of transit gateway %s: %v", config.GwName, err)
+	}
+
+	return resourceAviatrixTransitGatewayBgpConfigReadIfRequired(ctx, d, meta, &flag)
+}
+
+func resourceAviatrixTransitGatewayBgpConfigReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
+	if !(*flag) {
+		*flag = true
+		return resourceAviatrixTransitGatewayBgpConfigRead(ctx, d, meta)
+	}
+	return nil
+}
+
+func resourceAviatrixTransitGatewayBgpConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+	if gwName == "" {
+		id := d.Id()
+		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		d.Set("gw_name", id)
+		gwName = id
+	}
+
+	config, err := client.GetTransitGatewayBgpConfig(ctx, gwName)
+	if err != nil {
+		if err == goaviatrix.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read BGP config of transit gateway %s: %v", gwName, err)
+	}
+
+	d.Set("local_as_number", config.LocalASNumber)
+	if err := d.Set("prepend_as_path", config.PrependASPath); err != nil {
+		return diag.Errorf("failed to set prepend_as_path: %v", err)
+	}
+	d.Set("bgp_ecmp", config.BgpEcmp)
+	d.Set("bgp_polling_time", config.BgpPollingTime)
+	d.Set("bgp_hold_time", config.BgpHoldTime)
+
+	d.SetId(gwName)
+	return nil
+}
+
+func resourceAviatrixTransitGatewayBgpConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	config := marshalTransitGatewayBgpConfigInput(d)
+	gateway := &goaviatrix.TransitVpc{GwName: config.GwName}
+
+	if d.HasChanges("local_as_number", "prepend_as_path") {
+		// prepend_as_path must be deleted from the controller before local_as_number can be changed
+		if d.HasChange("local_as_number") || len(config.PrependASPath) == 0 {
+			if err := client.SetPrependASPath(gateway, nil); err != nil {
+				return diag.Errorf("failed to delete prepend AS path of transit gateway %s: %v", config.GwName, err)
+			}
+		}
+		if d.HasChange("local_as_number") {
+			if err := client.SetLocalASNumber(gateway, config.LocalASNumber); err != nil {
+				return diag.Errorf("failed to update local AS number of transit gateway %s: %v", config.GwName, err)
+			}
+		}
+		if len(config.PrependASPath) != 0 {
+			if err := client.SetPrependASPath(gateway, config.PrependASPath); err != nil {
+				return diag.Errorf("failed to update prepend AS path of transit gateway %s: %v", config.GwName, err)
+			}
+		}
+	}
+	if d.HasChange("bgp_ecmp") {
+		if err := client.SetBgpEcmp(gateway, config.BgpEcmp); err != nil {
+			return diag.Errorf("failed to update BGP ECMP of transit gateway %s: %v", config.GwName, err)
+		}
+	}
+	if d.HasChange("bgp_polling_time") {
+		if err := client.SetBgpPollingTime(gateway, strconv.Itoa(config.BgpPollingTime)); err != nil {
+			return diag.Errorf("failed to update BGP polling time of transit gateway %s: %v", config.GwName, err)
+		}
+	}
+	if d.HasChange("bgp_hold_time") {
+		if err := client.ChangeBgpHoldTime(config.GwName, config.BgpHoldTime); err != nil {
+			return diag.Errorf("failed to update BGP hold time of transit gateway %s: %v", config.GwName, err)
+		}
+	}
+
+	return resourceAviatrixTransitGatewayBgpConfigRead(ctx, d, meta)
+}
+
+func resourceAviatrixTransitGatewayBgpConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+	gateway := &goaviatrix.TransitVpc{GwName: gwName}
+
+	// The local AS number is left as is, since the connections of the transit gateway may depend on it.
+	if len(getStringList(d, "prepend_as_path")) != 0 {
+		if err := client.SetPrependASPath(gateway, nil); err != nil {
+			return diag.Errorf("failed to delete prepend AS path of transit gateway %s: %v", gwName, err)
+		}
+	}
+	if err := client.SetBgpEcmp(gateway, false); err != nil {
+		return diag.Errorf("failed to disable BGP ECMP of transit gateway %s: %v", gwName, err)
+	}
+	if err := client.SetBgpPollingTime(gateway, strconv.Itoa(goaviatrix.DefaultBgpPollingTime)); err != nil {
+		return diag.Errorf("failed to reset BGP polling time of transit gateway %s: %v", gwName, err)
+	}
+	if err := client.ChangeBgpHoldTime(gwName, goaviatrix.DefaultBgpHoldTime); err != nil {
+		return diag.Errorf("failed to reset BGP hold time of transit gateway %s: %v", gwName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixTransitGatewayBgpConfig_basic(t *testing.T) {
+	if os.Getenv("SKIP_TRANSIT_GATEWAY_BGP_CONFIG") == "yes" {
+		t.Skip("Skipping transit gateway BGP config test as SKIP_TRANSIT_GATEWAY_BGP_CONFIG is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_transit_gateway_bgp_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, ". Set SKIP_TRANSIT_GATEWAY_BGP_CONFIG to yes to skip transit gateway BGP config tests")
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTransitGatewayBgpConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayBgpConfigBasic(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayBgpConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "local_as_number", "65001"),
+					resource.TestCheckResourceAttr(resourceName, "prepend_as_path.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_ecmp", "true"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_polling_time", "30"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_hold_time", "90"),
+				),
+			},
+			{
+				Config: testAccTransitGatewayBgpConfigBasic(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayBgpConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bgp_polling_time", "20"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayBgpConfigBasic(rName string, bgpPollingTime int) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test" {
+	account_name       = "tfa-%[1]s"
+	cloud_type         = 1
+	aws_account_number = "%[2]s"
+	aws_iam            = false
+	aws_access_key     = "%[3]s"
+	aws_secret_key     = "%[4]s"
+}
+
+resource "aviatrix_transit_gateway" "test" {
+	cloud_type        = 1
+	account_name      = aviatrix_account.test.account_name
+	gw_name           = "tfg-%[1]s"
+	vpc_id            = "%[5]s"
+	vpc_reg           = "%[6]s"
+	gw_size           = "t2.micro"
+	subnet            = "%[7]s"
+	manage_bgp_config = false
+}
+
+resource "aviatrix_transit_gateway_bgp_config" "test" {
+	gw_name          = aviatrix_transit_gateway.test.gw_name
+	local_as_number  = "65001"
+	prepend_as_path  = ["65001", "65001"]
+	bgp_ecmp         = true
+	bgp_polling_time = %[8]d
+	bgp_hold_time    = 90
+}
+`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"), bgpPollingTime)
+}
+
+func testAccCheckTransitGatewayBgpConfigExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("transit gateway BGP config not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no transit gateway BGP config ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		config, err := client.GetTransitGatewayBgpConfig(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if config.LocalASNumber != rs.Primary.Attributes["local_as_number"] {
+			return fmt.Errorf("transit gateway BGP config local AS number mismatch: expected %s, got %s",
+				rs.Primary.Attributes["local_as_number"], config.LocalASNumber)
+		}
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayBgpConfigDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_transit_gateway_bgp_config" {
+			continue
+		}
+
+		config, err := client.GetTransitGatewayBgpConfig(context.Background(), rs.Primary.ID)
+		if err == goaviatrix.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if len(config.PrependASPath) != 0 || config.BgpEcmp || config.BgpPollingTime != goaviatrix.DefaultBgpPollingTime ||
+			config.BgpHoldTime != goaviatrix.DefaultBgpHoldTime {
+			return fmt.Errorf("transit gateway BGP config still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,361 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixTransitPeeringMesh() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixTransitPeeringMeshCreate,
+		ReadWithoutTimeout:   resourceAviatrixTransitPeeringMeshRead,
+		UpdateWithoutTimeout: resourceAviatrixTransitPeeringMeshUpdate,
+		DeleteWithoutTimeout: resourceAviatrixTransitPeeringMeshDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceAviatrixTransitPeeringMeshCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"transit_gateways": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 2,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				Description: "Names of the transit gateways of the mesh. Every gateway is peered with every other gateway.",
+			},
+			"excluded_network_cidrs": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+				Description: "List of network CIDRs excluded on both sides of every peering of the mesh.",
+			},
+			"prepend_as_path": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 25,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: goaviatrix.ValidateASN,
+				},
+				Description: "AS Path Prepend applied by every gateway of the mesh on each of its peerings of the mesh.",
+			},
+			"enable_insane_mode_encryption_over_internet": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+				Description: "Enable Insane Mode Encryption over Internet for every peering of the mesh. " +
+					"Transit gateways must be in Insane Mode. Required with valid `tunnel_count`.",
+			},
+			"tunnel_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(2, 20),
+				Description:  "Number of public tunnels of every peering of the mesh. Required with `enable_insane_mode_encryption_over_internet`.",
+			},
+			"peerings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Transit gateway peerings of the mesh.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"transit_gateway_name1": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The first transit gateway of the peering.",
+						},
+						"transit_gateway_name2": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The second transit gateway of the peering.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandTransitPeeringMeshPeerings(peerings []interface{}) [][]string {
+	var pairs [][]string
+	for _, v := range peerings {
+		peering := v.(map[string]interface{})
+		pairs = append(pairs, []string{peering["transit_gateway_name1"].(string), peering["transit_gateway_name2"].(string)})
+	}
+	return pairs
+}
+
+func flattenTransitPeeringMeshPeerings(pairs [][]string) []map[string]interface{} {
+	var peerings []map[string]interface{}
+	for _, pair := range pairs {
+		peerings = append(peerings, map[string]interface{}{
+			"transit_gateway_name1": pair[0],
+			"transit_gateway_name2": pair[1],
+		})
+	}
+	return peerings
+}
+
+func transitPeeringMeshPairsEqual(a, b [][]string) bool {
+	return len(a) == len(b) && len(goaviatrix.DifferencePairSlice(a, b)) == 0
+}
+
+func transitPeeringMeshID(gateways []string) string {
+	sorted := append([]string{}, gateways...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, "~")
+}
+
+func resourceAviatrixTransitPeeringMeshCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	insaneModeOverInternet := d.Get("enable_insane_mode_encryption_over_internet").(bool)
+	tunnelCount := d.Get("tunnel_count").(int)
+	if tunnelCount != 0 && !insaneModeOverInternet {
+		return fmt.Errorf("tunnel_count is only valid when enable_insane_mode_encryption_over_internet is set to true")
+	}
+	if tunnelCount == 0 && insaneModeOverInternet {
+		return fmt.Errorf("enable_insane_mode_encryption_over_internet being set to true requires valid tunnel_count")
+	}
+
+	// Peerings of the mesh which are missing, or which belong to removed gateways, are reconciled by the next apply.
+	if d.Id() == "" || !d.NewValueKnown("transit_gateways") {
+		return nil
+	}
+	var gateways []string
+	for _, v := range d.Get("transit_gateways").(*schema.Set).List() {
+		gateways = append(gateways, v.(string))
+	}
+	desired := goaviatrix.TransitPeeringMeshPairs(gateways)
+	current := expandTransitPeeringMeshPeerings(d.Get("peerings").([]interface{}))
+	if !transitPeeringMeshPairsEqual(desired, current) {
+		return d.SetNewComputed("peerings")
+	}
+	return nil
+}
+
+func createTransitPeeringMeshPeering(d *schema.ResourceData, client *goaviatrix.Client, pair []string) error {
+	excludedCidrs := strings.Join(getStringList(d, "excluded_network_cidrs"), ",")
+	transitGatewayPeering := &goaviatrix.TransitGatewayPeering{
+		TransitGatewayName1:    pair[0],
+		TransitGatewayName2:    pair[1],
+		Gateway1ExcludedCIDRs:  excludedCidrs,
+		Gateway2ExcludedCIDRs:  excludedCidrs,
+		InsaneModeOverInternet: d.Get("enable_insane_mode_encryption_over_internet").(bool),
+		TunnelCount:            d.Get("tunnel_count").(int),
+	}
+
+	log.Printf("[INFO] Creating Aviatrix Transit Gateway peering: %#v", transitGatewayPeering)
+
+	if err := client.CreateTransitGatewayPeering(transitGatewayPeering); err != nil {
+		return fmt.Errorf("failed to create transit gateway peering %s~%s: %v", pair[0], pair[1], err)
+	}
+
+	if prependASPath := getStringList(d, "prepend_as_path"); len(prependASPath) != 0 {
+		return updateTransitPeeringMeshPrependASPath(client, pair, prependASPath)
+	}
+	return nil
+}
+
+func updateTransitPeeringMeshPrependASPath(client *goaviatrix.Client, pair []string, prependASPath []string) error {
+	for _, direction := range [][]string{{pair[0], pair[1]}, {pair[1], pair[0]}} {
+		transitGatewayPeering := &goaviatrix.TransitGatewayPeering{
+			TransitGatewayName1: direction[0],
+			TransitGatewayName2: direction[1],
+		}
+		if err := client.EditTransitConnectionASPathPrepend(transitGatewayPeering, prependASPath); err != nil {
+			return fmt.Errorf("could not set prepend_as_path of transit gateway %s for its peering with %s: %v", direction[0], direction[1], err)
+		}
+	}
+	return nil
+}
+
+func resourceAviatrixTransitPeeringMeshCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gateways := getStringSet(d, "transit_gateways")
+
+	d.SetId(transitPeeringMeshID(gateways))
+	flag := false
+	defer resourceAviatrixTransitPeeringMeshReadIfRequired(ctx, d, meta, &flag)
+
+	for _, pair := range goaviatrix.TransitPeeringMeshPairs(gateways) {
+		if err := createTransitPeeringMeshPeering(d, client, pair); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceAviatrixTransitPeeringMeshReadIfRequired(ctx, d, meta, &flag)
+}
+
+func resourceAviatrixTransitPeeringMeshReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
+	if !(*flag) {
+		*flag = true
+		return resourceAviatrixTransitPeeringMeshRead(ctx, d, meta)
+	}
+	return nil
+}
+
+func resourceAviatrixTransitPeeringMeshRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if d.Get("transit_gateways").(*schema.Set).Len() == 0 {
+		id := d.Id()
+		log.Printf("[DEBUG] Looks like an import, no transit gateway names received. Import Id is %s", id)
+		parts := strings.Split(id, "~")
+		if len(parts) < 2 {
+			return diag.Errorf("invalid import id expected transit_gateway_name1~transit_gateway_name2[~transit_gateway_name3...]")
+		}
+		d.Set("transit_gateways", parts)
+	}
+
+	configuredCidrs := getStringList(d, "excluded_network_cidrs")
+	excludedCidrs := configuredCidrs
+	configuredPrependASPath := strings.Join(getStringList(d, "prepend_as_path"), " ")
+	prependASPath := configuredPrependASPath
+
+	var pairs [][]string
+	for _, pair := range goaviatrix.TransitPeeringMeshPairs(getStringSet(d, "transit_gateways")) {
+		transitGatewayPeering, err := client.GetTransitGatewayPeeringDetails(&goaviatrix.TransitGatewayPeering{
+			TransitGatewayName1: pair[0],
+			TransitGatewayName2: pair[1],
+		})
+		if err == goaviatrix.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return diag.Errorf("could not get details of transit gateway peering %s~%s: %v", pair[0], pair[1], err)
+		}
+
+		if len(pairs) == 0 {
+			d.Set("enable_insane_mode_encryption_over_internet", transitGatewayPeering.InsaneModeOverInternet)
+			if transitGatewayPeering.InsaneModeOverInternet {
+				d.Set("tunnel_count", transitGatewayPeering.TunnelCount)
+			}
+		}
+		pairs = append(pairs, pair)
+
+		// The options are shared by all the peerings of the mesh, report the first peering which drifted.
+		for _, cidrs := range [][]string{transitGatewayPeering.Gateway1ExcludedCIDRsSlice, transitGatewayPeering.Gateway2ExcludedCIDRsSlice} {
+			if goaviatrix.Equivalent(excludedCidrs, configuredCidrs) && !goaviatrix.Equivalent(cidrs, configuredCidrs) {
+				excludedCidrs = cidrs
+			}
+		}
+		for _, prepend := range []string{transitGatewayPeering.PrependAsPath1, transitGatewayPeering.PrependAsPath2} {
+			if prependASPath == configuredPrependASPath && prepend != configuredPrependASPath {
+				prependASPath = prepend
+			}
+		}
+	}
+
+	if len(pairs) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	if err := setConfigValueIfEquivalent(d, "excluded_network_cidrs", configuredCidrs, excludedCidrs); err != nil {
+		return diag.Errorf("could not write excluded_network_cidrs to state: %v", err)
+	}
+	if err := d.Set("prepend_as_path", strings.Fields(prependASPath)); err != nil {
+		return diag.Errorf("could not set prepend_as_path: %v", err)
+	}
+	if err := d.Set("peerings", flattenTransitPeeringMeshPeerings(pairs)); err != nil {
+		return diag.Errorf("could not set peerings: %v", err)
+	}
+
+	d.SetId(transitPeeringMeshID(getStringSet(d, "transit_gateways")))
+	return nil
+}
+
+func resourceAviatrixTransitPeeringMeshUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	d.Partial(true)
+
+	oldPeerings, _ := d.GetChange("peerings")
+	current := expandTransitPeeringMeshPeerings(oldPeerings.([]interface{}))
+	gateways := getStringSet(d, "transit_gateways")
+	desired := goaviatrix.TransitPeeringMeshPairs(gateways)
+
+	toDelete := goaviatrix.DifferencePairSlice(current, desired)
+	toCreate := goaviatrix.DifferencePairSlice(desired, current)
+	toKeep := goaviatrix.DifferencePairSlice(desired, toCreate)
+
+	for _, pair := range toDelete {
+		log.Printf("[INFO] Deleting Aviatrix Transit Gateway peering: %s~%s", pair[0], pair[1])
+		err := client.DeleteTransitGatewayPeering(&goaviatrix.TransitGatewayPeering{
+			TransitGatewayName1: pair[0],
+			TransitGatewayName2: pair[1],
+		})
+		if err != nil {
+			return diag.Errorf("failed to delete transit gateway peering %s~%s: %v", pair[0], pair[1], err)
+		}
+	}
+
+	if d.HasChange("excluded_network_cidrs") {
+		excludedCidrs := strings.Join(getStringList(d, "excluded_network_cidrs"), ",")
+		for _, pair := range toKeep {
+			transitGatewayPeering := &goaviatrix.TransitGatewayPeering{
+				TransitGatewayName1:   pair[0],
+				TransitGatewayName2:   pair[1],
+				Gateway1ExcludedCIDRs: excludedCidrs,
+				Gateway2ExcludedCIDRs: excludedCidrs,
+			}
+			log.Printf("[INFO] Updating Aviatrix Transit Gateway peering: %#v", transitGatewayPeering)
+			if err := client.UpdateTransitGatewayPeering(transitGatewayPeering); err != nil {
+				return diag.Errorf("failed to update excluded_network_cidrs of transit gateway peering %s~%s: %v", pair[0], pair[1], err)
+			}
+		}
+	}
+
+	if d.HasChange("prepend_as_path") {
+		prependASPath := getStringList(d, "prepend_as_path")
+		for _, pair := range toKeep {
+			if err := updateTransitPeeringMeshPrependASPath(client, pair, prependASPath); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	for _, pair := range toCreate {
+		if err := createTransitPeeringMeshPeering(d, client, pair); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.Partial(false)
+	d.SetId(transitPeeringMeshID(gateways))
+	return resourceAviatrixTransitPeeringMeshRead(ctx, d, meta)
+}
+
+func resourceAviatrixTransitPeeringMeshDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	for _, pair := range expandTransitPeeringMeshPeerings(d.Get("peerings").([]interface{})) {
+		log.Printf("[INFO] Deleting Aviatrix Transit Gateway peering: %s~%s", pair[0], pair[1])
+		err := client.DeleteTransitGatewayPeering(&goaviatrix.TransitGatewayPeering{
+			TransitGatewayName1: pair[0],
+			TransitGatewayName2: pair[1],
+		})
+		if err != nil {
+			return diag.Errorf("failed to delete transit gateway peering %s~%s: %v", pair[0], pair[1], err)
+		}
+	}
+
+	return nil
+}
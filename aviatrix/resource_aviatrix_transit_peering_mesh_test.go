@@ -0,0 +1,132 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixTransitPeeringMesh_basic(t *testing.T) {
+	if os.Getenv("SKIP_TRANSIT_PEERING_MESH") == "yes" {
+		t.Skip("Skipping transit peering mesh test as SKIP_TRANSIT_PEERING_MESH is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_transit_peering_mesh.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preAvxTransitGatewayPeeringCheck(t, ". Set SKIP_TRANSIT_PEERING_MESH to yes to skip transit peering mesh tests")
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTransitPeeringMeshDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitPeeringMeshBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitPeeringMeshExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateways.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "peerings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "excluded_network_cidrs.0", "10.0.0.48/28"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTransitPeeringMeshBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test" {
+	account_name       = "tfa-%[1]s"
+	cloud_type         = 1
+	aws_account_number = "%[2]s"
+	aws_iam            = false
+	aws_access_key     = "%[3]s"
+	aws_secret_key     = "%[4]s"
+}
+
+resource "aviatrix_transit_gateway" "test1" {
+	cloud_type   = 1
+	account_name = aviatrix_account.test.account_name
+	gw_name      = "tfg-%[1]s"
+	vpc_id       = "%[5]s"
+	vpc_reg      = "%[6]s"
+	gw_size      = "t2.micro"
+	subnet       = "%[7]s"
+}
+
+resource "aviatrix_transit_gateway" "test2" {
+	cloud_type   = 1
+	account_name = aviatrix_account.test.account_name
+	gw_name      = "tfg2-%[1]s"
+	vpc_id       = "%[8]s"
+	vpc_reg      = "%[9]s"
+	gw_size      = "t2.micro"
+	subnet       = "%[10]s"
+}
+
+resource "aviatrix_transit_peering_mesh" "test" {
+	transit_gateways = [
+		aviatrix_transit_gateway.test1.gw_name,
+		aviatrix_transit_gateway.test2.gw_name,
+	]
+	excluded_network_cidrs = ["10.0.0.48/28"]
+}
+`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"),
+		os.Getenv("AWS_VPC_ID2"), os.Getenv("AWS_REGION2"), os.Getenv("AWS_SUBNET2"))
+}
+
+func testAccCheckTransitPeeringMeshExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("transit peering mesh not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no transit peering mesh ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		err := client.GetTransitGatewayPeering(&goaviatrix.TransitGatewayPeering{
+			TransitGatewayName1: rs.Primary.Attributes["peerings.0.transit_gateway_name1"],
+			TransitGatewayName2: rs.Primary.Attributes["peerings.0.transit_gateway_name2"],
+		})
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccCheckTransitPeeringMeshDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_transit_peering_mesh" {
+			continue
+		}
+
+		err := client.GetTransitGatewayPeering(&goaviatrix.TransitGatewayPeering{
+			TransitGatewayName1: rs.Primary.Attributes["peerings.0.transit_gateway_name1"],
+			TransitGatewayName2: rs.Primary.Attributes["peerings.0.transit_gateway_name2"],
+		})
+		if err != goaviatrix.ErrNotFound {
+			return fmt.Errorf("transit peering mesh still exists")
+		}
+	}
+
+	return nil
+}
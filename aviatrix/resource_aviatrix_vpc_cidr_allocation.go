@@ -0,0 +1,132 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// vpcCidrAllocations tracks the CIDRs of the aviatrix_vpc_cidr_allocation resources created or refreshed by
+// this provider instance, so that new allocations never overlap them before their VPCs exist.
+var vpcCidrAllocations = struct {
+	sync.Mutex
+	cidrs map[string]string
+}{cidrs: make(map[string]string)}
+
+func resourceAviatrixVpcCidrAllocation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixVpcCidrAllocationCreate,
+		ReadWithoutTimeout:   resourceAviatrixVpcCidrAllocationRead,
+		UpdateWithoutTimeout: resourceAviatrixVpcCidrAllocationUpdate,
+		DeleteWithoutTimeout: resourceAviatrixVpcCidrAllocationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Unique name of the allocation.",
+			},
+			"supernet": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  "IPv4 CIDR to allocate from.",
+			},
+			"prefix_length": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 32),
+				Description:  "Prefix length of the allocated CIDR.",
+			},
+			"exclude_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+				Description: "Additional CIDRs to exclude from allocation. Only used when the CIDR is allocated.",
+			},
+			"cidr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Allocated CIDR.",
+			},
+		},
+	}
+}
+
+func resourceAviatrixVpcCidrAllocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	used, err := client.GetUsedVpcCidrs()
+	if err != nil {
+		return diag.Errorf("failed to get VPC CIDRs from the VPC tracker: %s", err)
+	}
+
+	cidr, err := allocateVpcCidr(d.Get("name").(string), d.Get("supernet").(string), d.Get("prefix_length").(int),
+		append(used, getStringSet(d, "exclude_cidrs")...))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("cidr", cidr)
+	d.SetId(d.Get("name").(string))
+	return nil
+}
+
+// allocateVpcCidr allocates the next free CIDR of supernet that overlaps neither used nor the known
+// allocations, and registers it under name.
+func allocateVpcCidr(name, supernet string, prefixLength int, used []string) (string, error) {
+	vpcCidrAllocations.Lock()
+	defer vpcCidrAllocations.Unlock()
+
+	if _, ok := vpcCidrAllocations.cidrs[name]; ok {
+		return "", fmt.Errorf("failed to allocate VPC CIDR %q: an allocation with the same name already exists", name)
+	}
+	for _, cidr := range vpcCidrAllocations.cidrs {
+		used = append(used, cidr)
+	}
+
+	cidrs, err := goaviatrix.NextAvailableCidrs(supernet, prefixLength, 1, used)
+	if err != nil {
+		return "", fmt.Errorf("failed to allocate VPC CIDR %q: %v", name, err)
+	}
+
+	vpcCidrAllocations.cidrs[name] = cidrs[0]
+	return cidrs[0], nil
+}
+
+func resourceAviatrixVpcCidrAllocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The allocation only lives in state, so register it for the allocations made later by this provider instance.
+	if cidr := d.Get("cidr").(string); cidr != "" {
+		vpcCidrAllocations.Lock()
+		vpcCidrAllocations.cidrs[d.Id()] = cidr
+		vpcCidrAllocations.Unlock()
+	}
+	return nil
+}
+
+func resourceAviatrixVpcCidrAllocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// exclude_cidrs is only used during allocation, changing it never moves an existing allocation.
+	return resourceAviatrixVpcCidrAllocationRead(ctx, d, meta)
+}
+
+func resourceAviatrixVpcCidrAllocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcCidrAllocations.Lock()
+	// Only forget the allocation if it was not replaced by a new one with the same name
+	if vpcCidrAllocations.cidrs[d.Id()] == d.Get("cidr").(string) {
+		delete(vpcCidrAllocations.cidrs, d.Id())
+	}
+	vpcCidrAllocations.Unlock()
+	return nil
+}
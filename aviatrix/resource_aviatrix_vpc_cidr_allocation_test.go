@@ -0,0 +1,159 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixVpcCidrAllocation_basic(t *testing.T) {
+	if os.Getenv("SKIP_VPC_CIDR_ALLOCATION") == "yes" {
+		t.Skip("Skipping VPC CIDR Allocation test as SKIP_VPC_CIDR_ALLOCATION is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_vpc_cidr_allocation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcCidrAllocationBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcCidrAllocationInSupernet(resourceName, "10.128.0.0/16"),
+					testAccCheckVpcCidrAllocationInSupernet("aviatrix_vpc_cidr_allocation.test2", "10.128.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("tfa-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "prefix_length", "24"),
+					testAccCheckVpcCidrAllocationsDiffer(resourceName, "aviatrix_vpc_cidr_allocation.test2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAviatrixVpcCidrAllocation_duplicateName(t *testing.T) {
+	if os.Getenv("SKIP_VPC_CIDR_ALLOCATION") == "yes" {
+		t.Skip("Skipping VPC CIDR Allocation test as SKIP_VPC_CIDR_ALLOCATION is set")
+	}
+
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVpcCidrAllocationDuplicateName(rName),
+				ExpectError: regexp.MustCompile("an allocation with the same name already exists"),
+			},
+		},
+	})
+}
+
+func TestVpcCidrAllocationExcludesAllocationsInState(t *testing.T) {
+	existing := schema.TestResourceDataRaw(t, resourceAviatrixVpcCidrAllocation().Schema, map[string]interface{}{
+		"name":          "existing",
+		"supernet":      "10.128.0.0/16",
+		"prefix_length": 24,
+	})
+	existing.SetId("existing")
+	existing.Set("cidr", "10.128.0.0/24")
+	defer func() {
+		vpcCidrAllocations.Lock()
+		delete(vpcCidrAllocations.cidrs, "existing")
+		delete(vpcCidrAllocations.cidrs, "new")
+		vpcCidrAllocations.Unlock()
+	}()
+
+	if diags := resourceAviatrixVpcCidrAllocationRead(context.Background(), existing, nil); diags.HasError() {
+		t.Fatalf("failed to read allocation: %v", diags)
+	}
+	cidr, err := allocateVpcCidr("new", "10.128.0.0/16", 24, nil)
+	if err != nil {
+		t.Fatalf("failed to allocate CIDR: %v", err)
+	}
+	if cidr != "10.128.1.0/24" {
+		t.Fatalf("expected 10.128.1.0/24, got %s", cidr)
+	}
+	if _, err := allocateVpcCidr("existing", "10.128.0.0/16", 24, nil); err == nil {
+		t.Fatalf("expected an error for a duplicate allocation name")
+	}
+}
+
+func testAccVpcCidrAllocationBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_vpc_cidr_allocation" "test" {
+	name          = "tfa-%[1]s"
+	supernet      = "10.128.0.0/16"
+	prefix_length = 24
+}
+
+resource "aviatrix_vpc_cidr_allocation" "test2" {
+	name          = "tfa2-%[1]s"
+	supernet      = "10.128.0.0/16"
+	prefix_length = 24
+	exclude_cidrs = ["10.128.0.0/20"]
+}
+`, rName)
+}
+
+func testAccVpcCidrAllocationDuplicateName(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_vpc_cidr_allocation" "test" {
+	name          = "tfa-%[1]s"
+	supernet      = "10.128.0.0/16"
+	prefix_length = 24
+}
+
+resource "aviatrix_vpc_cidr_allocation" "test2" {
+	name          = "tfa-%[1]s"
+	supernet      = "10.128.0.0/16"
+	prefix_length = 24
+}
+`, rName)
+}
+
+func testAccCheckVpcCidrAllocationInSupernet(n, supernet string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("VPC CIDR allocation not found: %s", n)
+		}
+
+		_, superNet, _ := net.ParseCIDR(supernet)
+		ip, _, err := net.ParseCIDR(rs.Primary.Attributes["cidr"])
+		if err != nil {
+			return fmt.Errorf("invalid allocated CIDR: %v", err)
+		}
+		if !superNet.Contains(ip) {
+			return fmt.Errorf("allocated CIDR %s is not in %s", rs.Primary.Attributes["cidr"], supernet)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVpcCidrAllocationsDiffer(a, b string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		cidrA := s.RootModule().Resources[a].Primary.Attributes["cidr"]
+		cidrB := s.RootModule().Resources[b].Primary.Attributes["cidr"]
+		if cidrA == cidrB {
+			return fmt.Errorf("both allocations got CIDR %s", cidrA)
+		}
+
+		return nil
+	}
+}
@@ -54,7 +54,7 @@ func TestAccAviatrixVPNCertDownload_basic(t *testing.T) {
 func testAccVPNCertDownloadConfigBasic(rName string) string {
 	idpMetadata := os.Getenv("IDP_METADATA")
 	idpMetadataType := os.Getenv("IDP_METADATA_TYPE")
-	vpnUserConfig := testAccVPNUserConfigBasic(rName, "true", rName)
+	vpnUserConfig := testAccVPNUserConfigBasic(rName, "true", rName, false)
 	samlConfig := testAccSamlEndpointConfigBasic(rName, idpMetadata, idpMetadataType)
 	return vpnUserConfig + samlConfig + `
 resource "aviatrix_vpn_cert_download" "test_vpn_cert_download" {
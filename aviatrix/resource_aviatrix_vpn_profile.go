@@ -1,22 +1,25 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixProfileCreate,
-		Read:   resourceAviatrixProfileRead,
-		Update: resourceAviatrixProfileUpdate,
-		Delete: resourceAviatrixProfileDelete,
+		CreateWithoutTimeout: resourceAviatrixProfileCreate,
+		ReadWithoutTimeout:   resourceAviatrixProfileRead,
+		UpdateWithoutTimeout: resourceAviatrixProfileUpdate,
+		DeleteWithoutTimeout: resourceAviatrixProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceAviatrixProfileCustomizeDiff,
 
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -82,7 +85,54 @@ func resourceAviatrixProfile() *schema.Resource {
 	}
 }
 
-func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func expandProfileRules(policy []interface{}) []goaviatrix.ProfileRule {
+	var rules []goaviatrix.ProfileRule
+	for _, v := range policy {
+		if v == nil {
+			continue
+		}
+		rule := v.(map[string]interface{})
+		rules = append(rules, goaviatrix.ProfileRule{
+			Action:   rule["action"].(string),
+			Protocol: rule["proto"].(string),
+			Port:     rule["port"].(string),
+			Target:   rule["target"].(string),
+		})
+	}
+	return rules
+}
+
+func resourceAviatrixProfileCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("policy") || !d.NewValueKnown("base_rule") {
+		return nil
+	}
+
+	_, err := goaviatrix.CompileProfilePolicy(d.Get("base_rule").(string), expandProfileRules(d.Get("policy").([]interface{})))
+	if err != nil {
+		return fmt.Errorf("policy validation failed: %v", err)
+	}
+	return nil
+}
+
+// vpnProfileShadowWarnings returns a warning for each rule of the profile policy that never takes effect.
+func vpnProfileShadowWarnings(d *schema.ResourceData) diag.Diagnostics {
+	policy, err := goaviatrix.CompileProfilePolicy(d.Get("base_rule").(string), expandProfileRules(d.Get("policy").([]interface{})))
+	if err != nil {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	for _, shadow := range policy.ShadowedRules() {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("VPN profile %q has a rule which never takes effect", d.Get("name").(string)),
+			Detail:   shadow.String(),
+		})
+	}
+	return diags
+}
+
+func resourceAviatrixProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 
 	log.Printf("[INFO] Creating Aviatrix Profile: %v %T", d.Get("users"), d.Get("users"))
@@ -93,7 +143,7 @@ func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) err
 		Policy:   make([]goaviatrix.ProfileRule, 0),
 	}
 	if profile.Name == "" {
-		return fmt.Errorf("profile name can't be empty string")
+		return diag.Errorf("profile name can't be empty string")
 	}
 
 	manageUserAttachment := d.Get("manage_user_attachment").(bool)
@@ -103,7 +153,7 @@ func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) err
 		}
 	} else {
 		if len(d.Get("users").([]interface{})) != 0 {
-			return fmt.Errorf("'manage_user_attachment' is set false. Please empty 'users' and manage user attachment in other resource")
+			return diag.Errorf("'manage_user_attachment' is set false. Please empty 'users' and manage user attachment in other resource")
 		}
 	}
 
@@ -121,7 +171,7 @@ func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) err
 			}
 			err := client.ValidateProfileRule(profileRule)
 			if err != nil {
-				return fmt.Errorf("policy validation failed: %v", err)
+				return diag.Errorf("policy validation failed: %v", err)
 			}
 			profile.Policy = append(profile.Policy, *profileRule)
 		}
@@ -131,25 +181,25 @@ func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) err
 
 	d.SetId(profile.Name)
 	flag := false
-	defer resourceAviatrixProfileReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixProfileReadIfRequired(ctx, d, meta, &flag)
 
 	err := client.CreateProfile(profile)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix Profile: %s", err)
+		return diag.Errorf("failed to create Aviatrix Profile: %s", err)
 	}
 
-	return resourceAviatrixProfileReadIfRequired(d, meta, &flag)
+	return resourceAviatrixProfileReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixProfileReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixProfileReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixProfileRead(d, meta)
+		return resourceAviatrixProfileRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixProfileRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 
 	profileName := d.Get("name").(string)
@@ -168,7 +218,7 @@ func resourceAviatrixProfileRead(d *schema.ResourceData, meta interface{}) error
 
 	profileBase, errBase := client.GetProfileBasePolicy(profile)
 	if errBase != nil {
-		return fmt.Errorf("can't get profile base policy for profile: %s", profile.Name)
+		return diag.Errorf("can't get profile base policy for profile: %s", profile.Name)
 	}
 	d.Set("base_rule", profileBase.BaseRule)
 
@@ -180,7 +230,7 @@ func resourceAviatrixProfileRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find profile: %s", err)
+		return diag.Errorf("couldn't find profile: %s", err)
 	}
 	d.Set("name", profile.Name)
 	log.Printf("[TRACE] Profile policy %v", profile.Policy)
@@ -219,10 +269,10 @@ func resourceAviatrixProfileRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[INFO] Generated policies: %v", Policies)
 
 	d.SetId(profile.Name)
-	return nil
+	return vpnProfileShadowWarnings(d)
 }
 
-func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 
 	profile := &goaviatrix.Profile{
@@ -257,7 +307,7 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 		err := client.ValidateProfileRule(profileRule)
 		if err != nil {
-			return fmt.Errorf("policy validation failed: %v", err)
+			return diag.Errorf("policy validation failed: %v", err)
 		}
 		profile.Policy = append(profile.Policy, *profileRule)
 	}
@@ -265,10 +315,10 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[INFO] Reading Aviatrix Profile: %#v", profile)
 
 	if d.HasChange("name") {
-		return fmt.Errorf("cannot change name of a profile")
+		return diag.Errorf("cannot change name of a profile")
 	}
 	if d.HasChange("base_rule") {
-		return fmt.Errorf("cannot change base rule of a profile")
+		return diag.Errorf("cannot change base rule of a profile")
 	}
 	if manageUserAttachment {
 		if d.HasChange("users") {
@@ -291,7 +341,7 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) err
 			profile.UserList = toAddUsers
 			err := client.AttachUsers(profile)
 			if err != nil {
-				return fmt.Errorf("failed to attach User : %s", err)
+				return diag.Errorf("failed to attach User : %s", err)
 			}
 			//Detach all the removed Users
 			toDelGws := goaviatrix.Difference(oldUserList, newUserList)
@@ -299,12 +349,12 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) err
 			profile.UserList = toDelGws
 			err = client.DetachUsers(profile)
 			if err != nil {
-				return fmt.Errorf("failed to detach user : %s", err)
+				return diag.Errorf("failed to detach user : %s", err)
 			}
 		}
 	} else {
 		if len(d.Get("users").([]interface{})) != 0 {
-			return fmt.Errorf("'manage_user_attachment' is set false. Please empty 'users' and manage user attachment in other resource")
+			return diag.Errorf("'manage_user_attachment' is set false. Please empty 'users' and manage user attachment in other resource")
 		}
 	}
 
@@ -312,15 +362,15 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("policy") {
 		err := client.UpdateProfilePolicy(profile)
 		if err != nil {
-			return fmt.Errorf("failed to create Aviatrix Profile: %s", err)
+			return diag.Errorf("failed to create Aviatrix Profile: %s", err)
 		}
 	}
 
 	d.Partial(false)
-	return nil
+	return vpnProfileShadowWarnings(d)
 }
 
-func resourceAviatrixProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 
 	profile := &goaviatrix.Profile{
@@ -333,13 +383,13 @@ func resourceAviatrixProfileDelete(d *schema.ResourceData, meta interface{}) err
 		profile.UserList = goaviatrix.ExpandStringList(d.Get("users").([]interface{}))
 		err := client.DetachUsers(profile)
 		if err != nil {
-			return fmt.Errorf("failed to detach Users: %s", err)
+			return diag.Errorf("failed to detach Users: %s", err)
 		}
 	}
 
 	err := client.DeleteProfile(profile)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Profile: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Profile: %s", err)
 	}
 
 	return nil
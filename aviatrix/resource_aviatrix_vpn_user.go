@@ -1,6 +1,7 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -17,6 +18,7 @@ func resourceAviatrixVPNUser() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceAviatrixVPNUserCustomizeDiff,
 
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -77,10 +79,36 @@ func resourceAviatrixVPNUser() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"cert_reissue_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Changing this value issues a new certificate for the VPN user and revokes the current one. " +
+					"The new certificate is sent to the user's email.",
+			},
+			"cert_revoked": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Whether the certificate of the VPN user is revoked. Set to true to revoke it without " +
+					"deleting the user. Setting it back to false issues a new certificate.",
+			},
+			"cert_expiry": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiry of the certificate of the VPN user.",
+			},
 		},
 	}
 }
 
+func resourceAviatrixVPNUserCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Revoking or reissuing the certificate changes its expiry
+	if d.Id() != "" && d.HasChanges("cert_revoked", "cert_reissue_trigger") {
+		return d.SetNewComputed("cert_expiry")
+	}
+	return nil
+}
+
 func resourceAviatrixVPNUserCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*goaviatrix.Client)
 
@@ -136,6 +164,13 @@ func resourceAviatrixVPNUserCreate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if d.Get("cert_revoked").(bool) {
+		err := client.RevokeVPNUserCert(vpnUser)
+		if err != nil {
+			return fmt.Errorf("failed to revoke certificate of Aviatrix VPNUser: %s", err)
+		}
+	}
+
 	return resourceAviatrixVPNUserReadIfRequired(d, meta, &flag)
 }
 
@@ -185,6 +220,8 @@ func resourceAviatrixVPNUserRead(d *schema.ResourceData, meta interface{}) error
 			d.Set("user_email", vu.UserEmail)
 		}
 		d.Set("saml_endpoint", vu.SamlEndpoint)
+		d.Set("cert_expiry", vu.CertExpiry)
+		d.Set("cert_revoked", vu.CertRevoked)
 
 		manageUserAttachment := d.Get("manage_user_attachment").(bool)
 		if manageUserAttachment {
@@ -270,6 +307,24 @@ func resourceAviatrixVPNUserUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if d.HasChange("cert_revoked") && d.Get("cert_revoked").(bool) {
+		if d.HasChange("cert_reissue_trigger") {
+			return fmt.Errorf("can't reissue the certificate of a VPN user while revoking it")
+		}
+		err := client.RevokeVPNUserCert(vpnUser)
+		if err != nil {
+			return fmt.Errorf("failed to revoke certificate of Aviatrix VPNUser: %s", err)
+		}
+	} else if d.HasChanges("cert_revoked", "cert_reissue_trigger") {
+		if d.Get("cert_revoked").(bool) {
+			return fmt.Errorf("can't reissue the certificate of a VPN user with a revoked certificate. Please set 'cert_revoked' to false")
+		}
+		err := client.ReissueVPNUserCert(vpnUser)
+		if err != nil {
+			return fmt.Errorf("failed to reissue certificate of Aviatrix VPNUser: %s", err)
+		}
+	}
+
 	d.Partial(false)
 	return resourceAviatrixVPNUserRead(d, meta)
 }
@@ -33,13 +33,22 @@ func TestAccAviatrixVPNUser_basic(t *testing.T) {
 		CheckDestroy: testAccCheckVPNUserDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccVPNUserConfigBasic(rName, "false", ""),
+				Config: testAccVPNUserConfigBasic(rName, "false", "", false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVPNUserExists("aviatrix_vpn_user.test_vpn_user", &vpnUser),
 					resource.TestCheckResourceAttr(resourceName, "gw_name", fmt.Sprintf("tfl-%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, "vpc_id", os.Getenv("AWS_VPC_ID")),
 					resource.TestCheckResourceAttr(resourceName, "user_email", "user@xyz.com"),
 					resource.TestCheckResourceAttr(resourceName, "user_name", fmt.Sprintf("tfu-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "cert_revoked", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "cert_expiry"),
+				),
+			},
+			{
+				Config: testAccVPNUserConfigBasic(rName, "false", "", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPNUserExists("aviatrix_vpn_user.test_vpn_user", &vpnUser),
+					resource.TestCheckResourceAttr(resourceName, "cert_revoked", "true"),
 				),
 			},
 			{
@@ -52,7 +61,7 @@ func TestAccAviatrixVPNUser_basic(t *testing.T) {
 	})
 }
 
-func testAccVPNUserConfigBasic(rName string, samlEnabled string, endpointName string) string {
+func testAccVPNUserConfigBasic(rName string, samlEnabled string, endpointName string, certRevoked bool) string {
 	return fmt.Sprintf(`
 resource "aviatrix_account" "test_account" {
 	account_name       = "tfa-%s"
@@ -83,10 +92,11 @@ resource "aviatrix_vpn_user" "test_vpn_user" {
 	user_name  = "tfu-%s"
 	user_email = "user@xyz.com"
 	saml_endpoint = "%s"
+	cert_revoked  = %t
 }
 	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
 		rName, os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"), rName,
-		samlEnabled, rName, endpointName)
+		samlEnabled, rName, endpointName, certRevoked)
 }
 
 func testAccCheckVPNUserExists(n string, vpnUser *goaviatrix.VPNUser) resource.TestCheckFunc {
@@ -0,0 +1,536 @@
+package aviatrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixVPNUsers() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixVPNUsersCreate,
+		ReadWithoutTimeout:   resourceAviatrixVPNUsersRead,
+		UpdateWithoutTimeout: resourceAviatrixVPNUsersUpdate,
+		DeleteWithoutTimeout: resourceAviatrixVPNUsersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceAviatrixVPNUsersCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"gw_name"},
+				Description:  "VPC Id of Aviatrix VPN gateway.",
+			},
+			"gw_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"vpc_id"},
+				Description: "If ELB is enabled, this will be the name of the ELB, " +
+					"else it will be the name of the Aviatrix VPN gateway.",
+			},
+			"dns_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"dns_name", "vpc_id"},
+				Description:  "FQDN of a DNS based VPN service such as GeoVPN or UDP load balancer.",
+			},
+			"user": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        vpnUsersUserSchema(false),
+				Description: "VPN users to manage.",
+			},
+			"source_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Path to a CSV or JSON file listing additional VPN users to manage.",
+			},
+			"source_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"csv", "json"}, false),
+				Description:  "Format of source_file. Inferred from the file extension when not set.",
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+				Description:  "Maximum number of concurrent controller API calls.",
+			},
+			"users": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        vpnUsersUserSchema(true),
+				Description: "All VPN users of the VPN gateway or DNS name.",
+			},
+		},
+	}
+}
+
+func vpnUsersUserSchema(computed bool) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:        schema.TypeString,
+				Required:    !computed,
+				Computed:    computed,
+				Description: "VPN user name.",
+			},
+			"user_email": {
+				Type:        schema.TypeString,
+				Optional:    !computed,
+				Computed:    computed,
+				Description: "VPN User's email.",
+			},
+			"saml_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    !computed,
+				Computed:    computed,
+				Description: "This is the name of the SAML endpoint to which the user will be associated.",
+			},
+			"profiles": {
+				Type:        schema.TypeSet,
+				Optional:    !computed,
+				Computed:    computed,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Set of profiles for user to attach to.",
+			},
+		},
+	}
+}
+
+type vpnUserSpec struct {
+	UserName     string
+	UserEmail    string
+	SamlEndpoint string
+	Profiles     []string
+}
+
+func (u vpnUserSpec) toMap() map[string]interface{} {
+	var profiles []interface{}
+	for _, p := range u.Profiles {
+		profiles = append(profiles, p)
+	}
+	return map[string]interface{}{
+		"user_name":     u.UserName,
+		"user_email":    u.UserEmail,
+		"saml_endpoint": u.SamlEndpoint,
+		"profiles":      schema.NewSet(schema.HashString, profiles),
+	}
+}
+
+func expandVPNUserSpecs(users []interface{}) map[string]vpnUserSpec {
+	specs := make(map[string]vpnUserSpec)
+	for _, v := range users {
+		user := v.(map[string]interface{})
+		var profiles []string
+		for _, p := range user["profiles"].(*schema.Set).List() {
+			profiles = append(profiles, p.(string))
+		}
+		sort.Strings(profiles)
+		specs[user["user_name"].(string)] = vpnUserSpec{
+			UserName:     user["user_name"].(string),
+			UserEmail:    user["user_email"].(string),
+			SamlEndpoint: user["saml_endpoint"].(string),
+			Profiles:     profiles,
+		}
+	}
+	return specs
+}
+
+// parseVPNUsersFile parses a CSV or JSON export of VPN users. CSV files need a header row with a user_name
+// column and optionally user_email, saml_endpoint and profiles columns, where profiles are separated by
+// ';'. JSON files contain a list of objects with the same keys, with profiles as a list. SCIM style
+// objects with userName and emails keys, optionally wrapped in a ListResponse, are accepted as well.
+func parseVPNUsersFile(content []byte, format string) ([]vpnUserSpec, error) {
+	switch format {
+	case "csv":
+		return parseVPNUsersCSV(content)
+	case "json":
+		return parseVPNUsersJSON(content)
+	}
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+func parseVPNUsersCSV(content []byte) ([]vpnUserSpec, error) {
+	r := csv.NewReader(bytes.NewReader(content))
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("could not read CSV header: %v", err)
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["user_name"]; !ok {
+		return nil, fmt.Errorf("CSV header must contain a %q column", "user_name")
+	}
+
+	get := func(record []string, column string) string {
+		if i, ok := columns[column]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var users []vpnUserSpec
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read CSV record: %v", err)
+		}
+
+		user := vpnUserSpec{
+			UserName:     get(record, "user_name"),
+			UserEmail:    get(record, "user_email"),
+			SamlEndpoint: get(record, "saml_endpoint"),
+		}
+		for _, p := range strings.Split(get(record, "profiles"), ";") {
+			if p = strings.TrimSpace(p); p != "" {
+				user.Profiles = append(user.Profiles, p)
+			}
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+func parseVPNUsersJSON(content []byte) ([]vpnUserSpec, error) {
+	type scimEmail struct {
+		Value   string `json:"value"`
+		Primary bool   `json:"primary"`
+	}
+	type jsonUser struct {
+		UserName     string      `json:"user_name"`
+		SCIMUserName string      `json:"userName"`
+		UserEmail    string      `json:"user_email"`
+		Emails       []scimEmail `json:"emails"`
+		SamlEndpoint string      `json:"saml_endpoint"`
+		Profiles     []string    `json:"profiles"`
+	}
+
+	var jsonUsers []jsonUser
+	if err := json.Unmarshal(content, &jsonUsers); err != nil {
+		var listResponse struct {
+			Resources []jsonUser `json:"Resources"`
+		}
+		if err := json.Unmarshal(content, &listResponse); err != nil {
+			return nil, fmt.Errorf("could not decode JSON: %v", err)
+		}
+		jsonUsers = listResponse.Resources
+	}
+
+	var users []vpnUserSpec
+	for _, u := range jsonUsers {
+		user := vpnUserSpec{
+			UserName:     u.UserName,
+			UserEmail:    u.UserEmail,
+			SamlEndpoint: u.SamlEndpoint,
+			Profiles:     u.Profiles,
+		}
+		if user.UserName == "" {
+			user.UserName = u.SCIMUserName
+		}
+		if user.UserEmail == "" {
+			for _, email := range u.Emails {
+				if user.UserEmail == "" || email.Primary {
+					user.UserEmail = email.Value
+				}
+			}
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// desiredVPNUsers returns the VPN users declared inline and in source_file, keyed by user name.
+func desiredVPNUsers(users []interface{}, sourceFile, sourceFormat string) (map[string]vpnUserSpec, error) {
+	specs := expandVPNUserSpecs(users)
+	if sourceFile == "" {
+		return specs, nil
+	}
+
+	if sourceFormat == "" {
+		sourceFormat = strings.TrimPrefix(strings.ToLower(filepath.Ext(sourceFile)), ".")
+	}
+	content, err := os.ReadFile(sourceFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read source_file: %v", err)
+	}
+	fileUsers, err := parseVPNUsersFile(content, sourceFormat)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse source_file %q: %v", sourceFile, err)
+	}
+
+	for _, user := range fileUsers {
+		if user.UserName == "" {
+			return nil, fmt.Errorf("source_file %q contains a user without user name", sourceFile)
+		}
+		if _, ok := specs[user.UserName]; ok {
+			return nil, fmt.Errorf("VPN user %q is declared more than once", user.UserName)
+		}
+		sort.Strings(user.Profiles)
+		specs[user.UserName] = user
+	}
+	return specs, nil
+}
+
+func vpnUserSpecsEqual(a, b map[string]vpnUserSpec) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, userA := range a {
+		userB, ok := b[name]
+		if !ok || userA.UserEmail != userB.UserEmail || userA.SamlEndpoint != userB.SamlEndpoint ||
+			!goaviatrix.Equivalent(userA.Profiles, userB.Profiles) {
+			return false
+		}
+	}
+	return true
+}
+
+func flattenVPNUserSpecs(specs map[string]vpnUserSpec) []interface{} {
+	var users []interface{}
+	for _, user := range specs {
+		users = append(users, user.toMap())
+	}
+	return users
+}
+
+func resourceAviatrixVPNUsersCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	desired, err := desiredVPNUsers(d.Get("user").(*schema.Set).List(), d.Get("source_file").(string), d.Get("source_format").(string))
+	if err != nil {
+		return err
+	}
+
+	current := expandVPNUserSpecs(d.Get("users").(*schema.Set).List())
+	if d.Id() == "" || !vpnUserSpecsEqual(desired, current) {
+		return d.SetNew("users", flattenVPNUserSpecs(desired))
+	}
+	return nil
+}
+
+func vpnUsersTarget(d *schema.ResourceData) *goaviatrix.VPNUser {
+	target := &goaviatrix.VPNUser{
+		VpcID:   d.Get("vpc_id").(string),
+		GwName:  d.Get("gw_name").(string),
+		DnsName: d.Get("dns_name").(string),
+	}
+	target.DnsEnabled = target.DnsName != ""
+	return target
+}
+
+func resourceAviatrixVPNUsersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	target := vpnUsersTarget(d)
+	if target.DnsEnabled {
+		d.SetId(target.DnsName)
+	} else {
+		d.SetId(target.VpcID + "~" + target.GwName)
+	}
+
+	flag := false
+	defer resourceAviatrixVPNUsersReadIfRequired(ctx, d, meta, &flag)
+
+	if err := reconcileVPNUsers(d, meta, map[string]vpnUserSpec{}); err != nil {
+		return diag.Errorf("failed to create Aviatrix VPN users: %s", err)
+	}
+
+	return resourceAviatrixVPNUsersReadIfRequired(ctx, d, meta, &flag)
+}
+
+func resourceAviatrixVPNUsersReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
+	if !(*flag) {
+		*flag = true
+		return resourceAviatrixVPNUsersRead(ctx, d, meta)
+	}
+	return nil
+}
+
+func resourceAviatrixVPNUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if d.Get("vpc_id").(string) == "" && d.Get("dns_name").(string) == "" {
+		id := d.Id()
+		log.Printf("[DEBUG] Looks like an import, no vpc_id or dns_name received. Import Id is %s", id)
+		// GCP VPC IDs contain "~-~" themselves, so split on the last "~"
+		if i := strings.LastIndex(id, "~"); i != -1 {
+			d.Set("vpc_id", id[:i])
+			d.Set("gw_name", id[i+1:])
+		} else {
+			d.Set("dns_name", id)
+		}
+		d.Set("max_concurrency", 10)
+	}
+
+	vpnUsers, err := client.ListVPNUsers()
+	if err != nil {
+		return diag.Errorf("failed to list Aviatrix VPN users: %s", err)
+	}
+
+	target := vpnUsersTarget(d)
+	current := make(map[string]vpnUserSpec)
+	for _, vu := range vpnUsers {
+		if target.DnsEnabled {
+			if !vu.DnsEnabled || vu.DnsName != target.DnsName {
+				continue
+			}
+		} else if vu.DnsEnabled || vu.VpcID != target.VpcID || vu.GwName != target.GwName {
+			continue
+		}
+		profiles := append([]string(nil), vu.Profiles...)
+		sort.Strings(profiles)
+		current[vu.UserName] = vpnUserSpec{
+			UserName:     vu.UserName,
+			UserEmail:    vu.UserEmail,
+			SamlEndpoint: vu.SamlEndpoint,
+			Profiles:     profiles,
+		}
+	}
+
+	if err := d.Set("users", flattenVPNUserSpecs(current)); err != nil {
+		return diag.Errorf("failed to set users: %s", err)
+	}
+	return nil
+}
+
+func resourceAviatrixVPNUsersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("users") {
+		oldUsers, _ := d.GetChange("users")
+		current := expandVPNUserSpecs(oldUsers.(*schema.Set).List())
+		if err := reconcileVPNUsers(d, meta, current); err != nil {
+			return diag.Errorf("failed to update Aviatrix VPN users: %s", err)
+		}
+	}
+
+	return resourceAviatrixVPNUsersRead(ctx, d, meta)
+}
+
+func resourceAviatrixVPNUsersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	target := vpnUsersTarget(d)
+	var tasks []func() error
+	for name := range expandVPNUserSpecs(d.Get("users").(*schema.Set).List()) {
+		vpnUser := *target
+		vpnUser.UserName = name
+		tasks = append(tasks, func() error {
+			if err := client.DeleteVPNUser(&vpnUser); err != nil {
+				return fmt.Errorf("failed to delete VPN user %s: %v", vpnUser.UserName, err)
+			}
+			return nil
+		})
+	}
+
+	if err := runConcurrently(d.Get("max_concurrency").(int), tasks); err != nil {
+		return diag.Errorf("failed to delete Aviatrix VPN users: %s", err)
+	}
+	return nil
+}
+
+// reconcileVPNUsers creates, deletes and updates the profile attachments of VPN users so the users
+// of the target match the desired users in "users".
+func reconcileVPNUsers(d *schema.ResourceData, meta interface{}, current map[string]vpnUserSpec) error {
+	client := meta.(*goaviatrix.Client)
+
+	target := vpnUsersTarget(d)
+	desired := expandVPNUserSpecs(d.Get("users").(*schema.Set).List())
+
+	var tasks []func() error
+	for name := range current {
+		if _, ok := desired[name]; ok {
+			continue
+		}
+		vpnUser := *target
+		vpnUser.UserName = name
+		tasks = append(tasks, func() error {
+			log.Printf("[INFO] Deleting Aviatrix VPN user %s", vpnUser.UserName)
+			if err := client.DeleteVPNUser(&vpnUser); err != nil {
+				return fmt.Errorf("failed to delete VPN user %s: %v", vpnUser.UserName, err)
+			}
+			return nil
+		})
+	}
+
+	for name, user := range desired {
+		user := user
+		old, exists := current[name]
+		recreate := exists && (old.UserEmail != user.UserEmail || old.SamlEndpoint != user.SamlEndpoint)
+		if exists && !recreate && goaviatrix.Equivalent(old.Profiles, user.Profiles) {
+			continue
+		}
+
+		vpnUser := *target
+		vpnUser.UserName = name
+		vpnUser.UserEmail = user.UserEmail
+		vpnUser.SamlEndpoint = user.SamlEndpoint
+
+		toAttach := user.Profiles
+		var toDetach []string
+		if exists && !recreate {
+			toAttach = goaviatrix.Difference(user.Profiles, old.Profiles)
+			toDetach = goaviatrix.Difference(old.Profiles, user.Profiles)
+		}
+
+		tasks = append(tasks, func() error {
+			if recreate {
+				log.Printf("[INFO] Recreating Aviatrix VPN user %s", vpnUser.UserName)
+				if err := client.DeleteVPNUser(&vpnUser); err != nil {
+					return fmt.Errorf("failed to delete VPN user %s: %v", vpnUser.UserName, err)
+				}
+			}
+			if !exists || recreate {
+				log.Printf("[INFO] Creating Aviatrix VPN user %s", vpnUser.UserName)
+				if err := client.CreateVPNUser(&vpnUser); err != nil {
+					return fmt.Errorf("failed to create VPN user %s: %v", vpnUser.UserName, err)
+				}
+			}
+			for _, profileName := range toAttach {
+				profile := &goaviatrix.Profile{
+					Name:     profileName,
+					UserList: []string{vpnUser.UserName},
+				}
+				if err := client.AttachUsers(profile); err != nil {
+					return fmt.Errorf("failed to attach VPN user %s to profile %s: %v", vpnUser.UserName, profileName, err)
+				}
+			}
+			for _, profileName := range toDetach {
+				profile := &goaviatrix.Profile{
+					Name:     profileName,
+					UserList: []string{vpnUser.UserName},
+				}
+				if err := client.DetachUsers(profile); err != nil {
+					return fmt.Errorf("failed to detach VPN user %s from profile %s: %v", vpnUser.UserName, profileName, err)
+				}
+			}
+			return nil
+		})
+	}
+
+	return runConcurrently(d.Get("max_concurrency").(int), tasks)
+}
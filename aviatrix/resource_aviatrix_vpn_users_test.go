@@ -0,0 +1,256 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixVPNUsers_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_vpn_users.test"
+
+	skipAcc := os.Getenv("SKIP_VPN_USERS")
+	if skipAcc == "yes" {
+		t.Skip("Skipping VPN Users test as SKIP_VPN_USERS is set")
+	}
+	msg := ". Set SKIP_VPN_USERS to yes to skip VPN Users tests"
+
+	sourceFile := filepath.Join(t.TempDir(), "users.csv")
+	content := fmt.Sprintf("user_name,user_email\ntfu-%s-csv,csv@xyz.com\n", rName)
+	if err := os.WriteFile(sourceFile, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, msg)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPNUsersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPNUsersConfigBasic(rName, "user@xyz.com", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPNUsersExists(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "gw_name", fmt.Sprintf("tfl-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "2"),
+				),
+			},
+			{
+				Config: testAccVPNUsersConfigBasic(rName, "changed@xyz.com", sourceFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPNUsersExists(resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "3"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"user", "source_file"},
+			},
+		},
+	})
+}
+
+func testAccVPNUsersConfigBasic(rName, email, sourceFile string) string {
+	source := ""
+	if sourceFile != "" {
+		source = fmt.Sprintf("source_file = %q", sourceFile)
+	}
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test_account" {
+	account_name       = "tfa-%s"
+	cloud_type         = 1
+	aws_account_number = "%s"
+	aws_iam            = false
+	aws_access_key     = "%s"
+	aws_secret_key     = "%s"
+}
+resource "aviatrix_gateway" "test_gw" {
+	cloud_type   = 1
+	account_name = aviatrix_account.test_account.account_name
+	gw_name      = "tfg-%s"
+	vpc_id       = "%s"
+	vpc_reg      = "%s"
+	gw_size      = "t2.micro"
+	subnet       = "%s"
+	vpn_access   = true
+	vpn_cidr     = "192.168.43.0/24"
+	max_vpn_conn = "100"
+	enable_elb   = true
+	elb_name     = "tfl-%s"
+}
+resource "aviatrix_vpn_users" "test" {
+	vpc_id  = aviatrix_gateway.test_gw.vpc_id
+	gw_name = aviatrix_gateway.test_gw.elb_name
+	%s
+
+	user {
+		user_name  = "tfu-%s-1"
+		user_email = "%s"
+	}
+	user {
+		user_name  = "tfu-%s-2"
+		user_email = "user2@xyz.com"
+	}
+}
+	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		rName, os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"), rName,
+		source, rName, email, rName)
+}
+
+func testAccCheckVPNUsersExists(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("VPN users Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no VPN users ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		vpnUsers, err := client.ListVPNUsers()
+		if err != nil {
+			return err
+		}
+		found := 0
+		for _, vu := range vpnUsers {
+			if vu.VpcID == rs.Primary.Attributes["vpc_id"] && vu.GwName == rs.Primary.Attributes["gw_name"] {
+				found++
+			}
+		}
+		if found != expected {
+			return fmt.Errorf("expected %d VPN users, found %d", expected, found)
+		}
+		return nil
+	}
+}
+
+func testAccCheckVPNUsersDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_vpn_users" {
+			continue
+		}
+
+		vpnUsers, err := client.ListVPNUsers()
+		if err != nil {
+			return err
+		}
+		for _, vu := range vpnUsers {
+			if vu.VpcID == rs.Primary.Attributes["vpc_id"] && vu.GwName == rs.Primary.Attributes["gw_name"] {
+				return fmt.Errorf("VPN user %s still exists", vu.UserName)
+			}
+		}
+	}
+
+	return nil
+}
+
+func TestParseVPNUsersFile(t *testing.T) {
+	tt := []struct {
+		Name     string
+		Content  string
+		Format   string
+		Expected []vpnUserSpec
+		Err      bool
+	}{
+		{
+			"csv",
+			"user_name, user_email, saml_endpoint, profiles\nalice,alice@xyz.com,,dev;ops\nbob,,okta,\n",
+			"csv",
+			[]vpnUserSpec{
+				{UserName: "alice", UserEmail: "alice@xyz.com", Profiles: []string{"dev", "ops"}},
+				{UserName: "bob", SamlEndpoint: "okta"},
+			},
+			false,
+		},
+		{
+			"csv without user_name column",
+			"name,user_email\nalice,alice@xyz.com\n",
+			"csv",
+			nil,
+			true,
+		},
+		{
+			"json",
+			`[{"user_name": "alice", "user_email": "alice@xyz.com", "profiles": ["dev"]}]`,
+			"json",
+			[]vpnUserSpec{
+				{UserName: "alice", UserEmail: "alice@xyz.com", Profiles: []string{"dev"}},
+			},
+			false,
+		},
+		{
+			"scim list response",
+			`{"Resources": [{"userName": "alice", "emails": [{"value": "a@xyz.com"}, {"value": "alice@xyz.com", "primary": true}]}]}`,
+			"json",
+			[]vpnUserSpec{
+				{UserName: "alice", UserEmail: "alice@xyz.com"},
+			},
+			false,
+		},
+		{
+			"unsupported format",
+			"alice",
+			"txt",
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			users, err := parseVPNUsersFile([]byte(tc.Content), tc.Format)
+			if tc.Err {
+				if err == nil {
+					t.Fatalf("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %q", err)
+			}
+			if !reflect.DeepEqual(users, tc.Expected) {
+				t.Fatalf("expected %+v, got %+v", tc.Expected, users)
+			}
+		})
+	}
+}
+
+func TestDesiredVPNUsersDuplicate(t *testing.T) {
+	sourceFile := filepath.Join(t.TempDir(), "users.json")
+	if err := os.WriteFile(sourceFile, []byte(`[{"user_name": "alice"}]`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	inline := []interface{}{
+		vpnUserSpec{UserName: "alice"}.toMap(),
+	}
+	_, err := desiredVPNUsers(inline, sourceFile, "")
+	if err == nil || !strings.Contains(err.Error(), "more than once") {
+		t.Fatalf("expected a duplicate user error, got %v", err)
+	}
+
+	users, err := desiredVPNUsers(nil, sourceFile, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %q", err)
+	}
+	if _, ok := users["alice"]; !ok || len(users) != 1 {
+		t.Fatalf("expected only alice, got %+v", users)
+	}
+}
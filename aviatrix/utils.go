@@ -4,10 +4,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/go-version"
 
@@ -38,6 +40,19 @@ func validateCloudType(i interface{}, k string) (warnings []string, errors []err
 	return validation.IntInSlice(goaviatrix.GetSupportedClouds())(i, k)
 }
 
+// validateIPv4CIDRNetwork is a SchemaValidateFunc for IPv4 CIDR network parameters.
+func validateIPv4CIDRNetwork(i interface{}, k string) (warnings []string, errors []error) {
+	warnings, errors = validation.IsCIDRNetwork(0, 32)(i, k)
+	if len(errors) != 0 {
+		return warnings, errors
+	}
+
+	if _, ipNet, _ := net.ParseCIDR(i.(string)); ipNet.IP.To4() == nil {
+		errors = append(errors, fmt.Errorf("expected %s to be an IPv4 CIDR, got: %s", k, i))
+	}
+	return warnings, errors
+}
+
 func DiffSuppressFuncString(k, old, new string, d *schema.ResourceData) bool {
 	oldValue := strings.Split(old, ",")
 	newValue := strings.Split(new, ",")
@@ -376,3 +391,36 @@ func mapContains(m map[string]interface{}, key string) bool {
 		return !reflect.ValueOf(val).IsZero()
 	}
 }
+
+// runConcurrently runs the given tasks with at most limit of them in flight at a time, and returns an
+// error combining the errors of all failed tasks.
+func runConcurrently(limit int, tasks []func() error) error {
+	if limit < 1 {
+		limit = 1
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []string
+	sem := make(chan struct{}, limit)
+
+	for _, task := range tasks {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(task func() error) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := task(); err != nil {
+				mu.Lock()
+				errs = append(errs, err.Error())
+				mu.Unlock()
+			}
+		}(task)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%d of %d operations failed:\n%s", len(errs), len(tasks), strings.Join(errs, "\n"))
+	}
+	return nil
+}
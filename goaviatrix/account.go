@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -206,7 +205,7 @@ func (c *Client) CreateAWSSAccount(account *Account) error {
 	return c.PostFileAPI(params, files, DuplicateBasicCheck)
 }
 
-func (c *Client) GetAccount(account *Account) (*Account, error) {
+func (c *Client) ListAccounts() ([]Account, error) {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "list_accounts",
@@ -217,7 +216,14 @@ func (c *Client) GetAccount(account *Account) (*Account, error) {
 	if err != nil {
 		return nil, err
 	}
-	accList := resp.Results.AccountList
+	return resp.Results.AccountList, nil
+}
+
+func (c *Client) GetAccount(account *Account) (*Account, error) {
+	accList, err := c.ListAccounts()
+	if err != nil {
+		return nil, err
+	}
 	for i := range accList {
 		if accList[i].AccountName == account.AccountName {
 			log.Infof("Found Aviatrix Account %s", account.AccountName)
@@ -354,32 +360,60 @@ func (c *Client) UploadOciApiPrivateKeyFile(account *Account) error {
 }
 
 func (c *Client) AuditAccount(ctx context.Context, account *Account) error {
-	form := map[string]string{
-		"CID":    c.CID,
-		"action": "get_account_audit_records",
+	records, err := c.GetAccountAuditRecords(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if record.AccountName == account.AccountName && !record.Passed() {
+			return fmt.Errorf("%s", record.Comment)
+		}
 	}
+	return nil
+}
 
-	type AccountAuditResult struct {
-		AccountName string `json:"account_name"`
-		Status      string `json:"status"`
-		Comment     string `json:"comment"`
+// RunAccountAudit audits the current credentials of an account on the controller, unlike AuditAccount
+// which returns the result of the last periodic audit.
+func (c *Client) RunAccountAudit(ctx context.Context, account *Account) error {
+	form := map[string]string{
+		"CID":          c.CID,
+		"action":       "audit_account",
+		"account_name": account.AccountName,
 	}
 
-	type AccountAuditResponse struct {
-		Return  bool                 `json:"return"`
-		Results []AccountAuditResult `json:"results"`
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
+}
+
+// RotateAccountCredentials updates an account with new credentials using update and audits the account
+// right after. If the audit fails and previous is not nil, the previous credentials are restored using
+// update. An error is returned whenever the new credentials are not in use afterwards.
+func (c *Client) RotateAccountCredentials(ctx context.Context, account, previous *Account, update func(*Account) error) error {
+	audit := func(account *Account) error {
+		return c.RunAccountAudit(ctx, account)
 	}
+	return rotateAccountCredentials(account, previous, update, audit)
+}
 
-	var resp AccountAuditResponse
-	err := c.GetAPIContext(ctx, &resp, form["action"], form, BasicCheck)
-	if err != nil {
+func rotateAccountCredentials(account, previous *Account, update, audit func(*Account) error) error {
+	if err := update(account); err != nil {
 		return err
 	}
 
-	for _, accountAuditResult := range resp.Results {
-		if accountAuditResult.AccountName == account.AccountName && !strings.Contains(accountAuditResult.Status, "Pass") {
-			return fmt.Errorf("%s", accountAuditResult.Comment)
-		}
+	auditErr := audit(account)
+	if auditErr == nil {
+		return nil
 	}
-	return nil
+	if previous == nil {
+		return fmt.Errorf("account %s failed audit with the new credentials, which remain in use because the "+
+			"previous credentials can't be restored automatically: %v", account.AccountName, auditErr)
+	}
+
+	log.Warnf("Account %s failed audit with the new credentials, restoring the previous credentials", account.AccountName)
+	if err := update(previous); err != nil {
+		return fmt.Errorf("account %s failed audit with the new credentials: %v; restoring the previous "+
+			"credentials failed too: %v", account.AccountName, auditErr, err)
+	}
+	return fmt.Errorf("account %s failed audit with the new credentials, the previous credentials were "+
+		"restored: %v", account.AccountName, auditErr)
 }
@@ -0,0 +1,172 @@
+package goaviatrix
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// Types of the findings of an account audit
+const (
+	AccountAuditFindingMissingPermission  = "missing_permission"
+	AccountAuditFindingExpiredCredentials = "expired_credentials"
+	AccountAuditFindingInvalidCredentials = "invalid_credentials"
+	AccountAuditFindingRoleTrust          = "role_trust"
+	AccountAuditFindingOther              = "other"
+)
+
+// AccountAuditRecord is the result of the last audit of an account.
+type AccountAuditRecord struct {
+	AccountName string `json:"account_name"`
+	Status      string `json:"status"`
+	Comment     string `json:"comment"`
+}
+
+// AccountAuditFinding is a single issue found by an account audit.
+type AccountAuditFinding struct {
+	Type string
+	// Cloud permission missing from the account, only set for missing_permission findings
+	Permission string
+	Message    string
+}
+
+func (r *AccountAuditRecord) Passed() bool {
+	return strings.Contains(r.Status, "Pass")
+}
+
+// Findings returns the issues reported by a failed audit.
+func (r *AccountAuditRecord) Findings() []AccountAuditFinding {
+	if r.Passed() {
+		return nil
+	}
+	findings := ParseAccountAuditComment(r.Comment)
+	if len(findings) == 0 {
+		findings = append(findings, AccountAuditFinding{
+			Type:    AccountAuditFindingOther,
+			Message: r.Status,
+		})
+	}
+	return findings
+}
+
+func (c *Client) GetAccountAuditRecords(ctx context.Context) ([]AccountAuditRecord, error) {
+	form := map[string]string{
+		"CID":    c.CID,
+		"action": "get_account_audit_records",
+	}
+
+	type AccountAuditResponse struct {
+		Return  bool                 `json:"return"`
+		Results []AccountAuditRecord `json:"results"`
+	}
+
+	var resp AccountAuditResponse
+	err := c.GetAPIContext(ctx, &resp, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+var (
+	// AWS "service:Action", Azure "Microsoft.Provider/resource/action" and GCP "service.resource.verb" permissions
+	accountAuditPermissionRegexp       = regexp.MustCompile(`[A-Za-z0-9-]+:[A-Za-z0-9*]+|Microsoft\.[A-Za-z0-9./*]+|\b[a-z]+\.[a-zA-Z]+\.[a-zA-Z]+\b`)
+	accountAuditMissingPermissionsList = regexp.MustCompile(`(?i)missing (?:the following )?permissions?\s*:?\s*(.+)`)
+	accountAuditNotAuthorizedRegexp    = regexp.MustCompile(`(?i)(?:not authorized to perform|authorization to perform action|permission)\s*:?\s*'?([A-Za-z0-9.:/*-]+)'?`)
+
+	accountAuditRoleTrustMarkers = []string{
+		"sts:assumerole",
+		"trust relationship",
+		"trust policy",
+		"unable to assume role",
+		"cannot assume role",
+	}
+	accountAuditExpiredMarkers = []string{
+		"expired",
+		"aadsts7000222",
+	}
+	accountAuditInvalidMarkers = []string{
+		"security token included in the request is invalid",
+		"invalidclientsecret",
+		"invalid client secret",
+		"invalidaccesskeyid",
+		"access key id you provided does not exist",
+		"signaturedoesnotmatch",
+		"aadsts7000215",
+		"invalid_grant",
+		"invalid credentials",
+	}
+	accountAuditPermissionMarkers = []string{
+		"missing permission",
+		"not authorized to perform",
+		"authorizationfailed",
+		"does not have authorization",
+		"permission denied",
+		"permission '",
+		"access denied",
+		"accessdenied",
+	}
+)
+
+// ParseAccountAuditComment splits the free text comment of a failed account audit into findings. Each
+// line or ';' separated part of the comment is classified on its own, and a part listing missing
+// permissions yields one finding per permission.
+func ParseAccountAuditComment(comment string) []AccountAuditFinding {
+	var findings []AccountAuditFinding
+	parts := strings.FieldsFunc(comment, func(r rune) bool {
+		return r == '\n' || r == ';'
+	})
+	for _, part := range parts {
+		message := strings.TrimSpace(part)
+		if message == "" {
+			continue
+		}
+		lower := strings.ToLower(message)
+
+		switch {
+		case containsAny(lower, accountAuditRoleTrustMarkers):
+			findings = append(findings, AccountAuditFinding{Type: AccountAuditFindingRoleTrust, Message: message})
+		case containsAny(lower, accountAuditExpiredMarkers):
+			findings = append(findings, AccountAuditFinding{Type: AccountAuditFindingExpiredCredentials, Message: message})
+		case containsAny(lower, accountAuditInvalidMarkers):
+			findings = append(findings, AccountAuditFinding{Type: AccountAuditFindingInvalidCredentials, Message: message})
+		case containsAny(lower, accountAuditPermissionMarkers):
+			permissions := missingAccountPermissions(message)
+			if len(permissions) == 0 {
+				findings = append(findings, AccountAuditFinding{Type: AccountAuditFindingMissingPermission, Message: message})
+			}
+			for _, permission := range permissions {
+				findings = append(findings, AccountAuditFinding{
+					Type:       AccountAuditFindingMissingPermission,
+					Permission: permission,
+					Message:    message,
+				})
+			}
+		default:
+			findings = append(findings, AccountAuditFinding{Type: AccountAuditFindingOther, Message: message})
+		}
+	}
+	return findings
+}
+
+func missingAccountPermissions(message string) []string {
+	if m := accountAuditMissingPermissionsList.FindStringSubmatch(message); m != nil {
+		return accountAuditPermissionRegexp.FindAllString(m[1], -1)
+	}
+	var permissions []string
+	for _, m := range accountAuditNotAuthorizedRegexp.FindAllStringSubmatch(message, -1) {
+		if accountAuditPermissionRegexp.MatchString(m[1]) {
+			permissions = append(permissions, m[1])
+		}
+	}
+	return permissions
+}
+
+func containsAny(s string, substrings []string) bool {
+	for _, substring := range substrings {
+		if strings.Contains(s, substring) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package goaviatrix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseAccountAuditComment(t *testing.T) {
+	tt := []struct {
+		Name     string
+		Comment  string
+		Expected []AccountAuditFinding
+	}{
+		{
+			"empty comment",
+			"",
+			nil,
+		},
+		{
+			"missing permission list",
+			"Role aviatrix-role-app is missing permissions: ec2:DescribeVpcs, iam:PassRole",
+			[]AccountAuditFinding{
+				{
+					Type:       AccountAuditFindingMissingPermission,
+					Permission: "ec2:DescribeVpcs",
+					Message:    "Role aviatrix-role-app is missing permissions: ec2:DescribeVpcs, iam:PassRole",
+				},
+				{
+					Type:       AccountAuditFindingMissingPermission,
+					Permission: "iam:PassRole",
+					Message:    "Role aviatrix-role-app is missing permissions: ec2:DescribeVpcs, iam:PassRole",
+				},
+			},
+		},
+		{
+			"aws not authorized",
+			"User: arn:aws:iam::123456789012:user/aviatrix is not authorized to perform: ec2:CreateVpc on resource: *",
+			[]AccountAuditFinding{
+				{
+					Type:       AccountAuditFindingMissingPermission,
+					Permission: "ec2:CreateVpc",
+					Message:    "User: arn:aws:iam::123456789012:user/aviatrix is not authorized to perform: ec2:CreateVpc on resource: *",
+				},
+			},
+		},
+		{
+			"azure authorization failed",
+			"AuthorizationFailed: The client 'abc' does not have authorization to perform action 'Microsoft.Network/virtualNetworks/read' over scope '/subscriptions/123'",
+			[]AccountAuditFinding{
+				{
+					Type:       AccountAuditFindingMissingPermission,
+					Permission: "Microsoft.Network/virtualNetworks/read",
+					Message:    "AuthorizationFailed: The client 'abc' does not have authorization to perform action 'Microsoft.Network/virtualNetworks/read' over scope '/subscriptions/123'",
+				},
+			},
+		},
+		{
+			"gcp permission denied",
+			"Permission 'compute.networks.list' denied on resource 'projects/test'",
+			[]AccountAuditFinding{
+				{
+					Type:       AccountAuditFindingMissingPermission,
+					Permission: "compute.networks.list",
+					Message:    "Permission 'compute.networks.list' denied on resource 'projects/test'",
+				},
+			},
+		},
+		{
+			"role trust and expired credentials",
+			"User is not authorized to perform: sts:AssumeRole on resource aviatrix-role-app\nAADSTS7000222: The provided client secret keys are expired.",
+			[]AccountAuditFinding{
+				{
+					Type:    AccountAuditFindingRoleTrust,
+					Message: "User is not authorized to perform: sts:AssumeRole on resource aviatrix-role-app",
+				},
+				{
+					Type:    AccountAuditFindingExpiredCredentials,
+					Message: "AADSTS7000222: The provided client secret keys are expired.",
+				},
+			},
+		},
+		{
+			"invalid credentials and unknown issue",
+			"The security token included in the request is invalid; VPC limit reached",
+			[]AccountAuditFinding{
+				{
+					Type:    AccountAuditFindingInvalidCredentials,
+					Message: "The security token included in the request is invalid",
+				},
+				{
+					Type:    AccountAuditFindingOther,
+					Message: "VPC limit reached",
+				},
+			},
+		},
+		{
+			"access denied without permission",
+			"Access denied",
+			[]AccountAuditFinding{
+				{
+					Type:    AccountAuditFindingMissingPermission,
+					Message: "Access denied",
+				},
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			findings := ParseAccountAuditComment(test.Comment)
+			if !reflect.DeepEqual(findings, test.Expected) {
+				t.Fatalf("expected %#v, got %#v", test.Expected, findings)
+			}
+		})
+	}
+}
+
+func TestAccountAuditRecordFindings(t *testing.T) {
+	passed := AccountAuditRecord{AccountName: "test", Status: "Pass", Comment: "ignored"}
+	if findings := passed.Findings(); findings != nil {
+		t.Fatalf("expected no findings for a passed audit, got %#v", findings)
+	}
+
+	failed := AccountAuditRecord{AccountName: "test", Status: "Fail"}
+	expected := []AccountAuditFinding{{Type: AccountAuditFindingOther, Message: "Fail"}}
+	if findings := failed.Findings(); !reflect.DeepEqual(findings, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, findings)
+	}
+}
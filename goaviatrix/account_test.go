@@ -0,0 +1,93 @@
+package goaviatrix
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRotateAccountCredentials(t *testing.T) {
+	tt := []struct {
+		Name        string
+		HasPrevious bool
+		UpdateErr   map[string]error
+		AuditErr    error
+		Updates     []string
+		ExpectedErr string
+	}{
+		{
+			"audit passes",
+			true,
+			nil,
+			nil,
+			[]string{"new"},
+			"",
+		},
+		{
+			"update fails",
+			true,
+			map[string]error{"new": errors.New("invalid key")},
+			nil,
+			[]string{"new"},
+			"invalid key",
+		},
+		{
+			"audit fails and rolls back",
+			true,
+			nil,
+			errors.New("access denied"),
+			[]string{"new", "old"},
+			"the previous credentials were restored: access denied",
+		},
+		{
+			"audit fails without previous credentials",
+			false,
+			nil,
+			errors.New("access denied"),
+			[]string{"new"},
+			"remain in use",
+		},
+		{
+			"audit and rollback fail",
+			true,
+			map[string]error{"old": errors.New("key deleted")},
+			errors.New("access denied"),
+			[]string{"new", "old"},
+			"restoring the previous credentials failed too: key deleted",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			account := &Account{AccountName: "test", AwsAccessKey: "new"}
+			var previous *Account
+			if tc.HasPrevious {
+				previous = &Account{AccountName: "test", AwsAccessKey: "old"}
+			}
+
+			var updates []string
+			update := func(a *Account) error {
+				updates = append(updates, a.AwsAccessKey)
+				return tc.UpdateErr[a.AwsAccessKey]
+			}
+			audit := func(a *Account) error {
+				return tc.AuditErr
+			}
+
+			err := rotateAccountCredentials(account, previous, update, audit)
+			if !reflect.DeepEqual(updates, tc.Updates) {
+				t.Fatalf("expected updates %v, got %v", tc.Updates, updates)
+			}
+			if tc.ExpectedErr == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %q", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.ExpectedErr) {
+				t.Fatalf("expected an error containing %q, got %v", tc.ExpectedErr, err)
+			}
+		})
+	}
+}
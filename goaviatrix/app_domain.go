@@ -176,3 +176,45 @@ func (c *Client) DeleteAppDomain(ctx context.Context, uuid string) error {
 	endpoint := fmt.Sprintf("app-domains/%s", uuid)
 	return c.DeleteAPIContext25(ctx, endpoint, nil)
 }
+
+type AppDomainResource struct {
+	Type        string   `json:"type"`
+	ResId       string   `json:"res_id"`
+	Name        string   `json:"name"`
+	AccountId   string   `json:"account_id"`
+	AccountName string   `json:"account_name"`
+	Region      string   `json:"region"`
+	Zone        string   `json:"zone"`
+	VpcId       string   `json:"vpc_id"`
+	CIDRs       []string `json:"cidrs"`
+}
+
+type AppDomainResourcesResp struct {
+	Resources []*AppDomainResource `json:"resources"`
+}
+
+// GetAppDomainResources returns the resources an existing App Domain's selector currently resolves to.
+func (c *Client) GetAppDomainResources(ctx context.Context, uuid string) ([]*AppDomainResource, error) {
+	endpoint := fmt.Sprintf("app-domains/%s/resources", uuid)
+
+	var data AppDomainResourcesResp
+	err := c.GetAPIContext25(ctx, &data, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	return data.Resources, nil
+}
+
+// PreviewAppDomainResources returns the resources the given App Domain's selector would resolve to,
+// without creating the App Domain.
+func (c *Client) PreviewAppDomainResources(ctx context.Context, appDomain *AppDomain) ([]*AppDomainResource, error) {
+	endpoint := "app-domains/preview"
+	form := makeAppDomainForm(appDomain)
+
+	var data AppDomainResourcesResp
+	err := c.PostAPIContext25(ctx, &data, endpoint, form)
+	if err != nil {
+		return nil, err
+	}
+	return data.Resources, nil
+}
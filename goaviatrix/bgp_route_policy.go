@@ -0,0 +1,319 @@
+package goaviatrix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	BgpRouteMapDirectionInbound  = "inbound"
+	BgpRouteMapDirectionOutbound = "outbound"
+)
+
+var BgpRouteMapActions = []string{"permit", "deny"}
+
+// BgpWellKnownCommunities are the well known BGP communities accepted by ValidateBgpCommunity.
+var BgpWellKnownCommunities = []string{"internet", "local-AS", "no-advertise", "no-export"}
+
+// BgpPrefixList is a named, ordered list of prefix match rules.
+type BgpPrefixList struct {
+	Name        string               `json:"name"`
+	Description string               `json:"description"`
+	Rules       []*BgpPrefixListRule `json:"rules"`
+}
+
+// BgpPrefixListRule matches a prefix and, optionally, a range of prefix lengths within it. A zero
+// GeMaskLength or LeMaskLength means the bound is not set.
+type BgpPrefixListRule struct {
+	Sequence     int    `json:"sequence"`
+	Action       string `json:"action"`
+	Prefix       string `json:"prefix"`
+	GeMaskLength int    `json:"ge,omitempty"`
+	LeMaskLength int    `json:"le,omitempty"`
+}
+
+// BgpRouteMap is a named, ordered list of match and set rules applied to the routes of a BGP session.
+type BgpRouteMap struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Rules       []*BgpRouteMapRule `json:"rules"`
+}
+
+// BgpRouteMapRule matches routes by prefix list and community, and sets the attributes of the matching
+// routes. A zero SetLocalPreference or SetMed means the attribute is not modified.
+type BgpRouteMapRule struct {
+	Sequence             int      `json:"sequence"`
+	Action               string   `json:"action"`
+	MatchPrefixList      string   `json:"match_prefix_list,omitempty"`
+	MatchCommunities     []string `json:"match_communities,omitempty"`
+	SetLocalPreference   int      `json:"set_local_preference,omitempty"`
+	SetMed               int      `json:"set_med,omitempty"`
+	SetCommunities       []string `json:"set_communities,omitempty"`
+	SetCommunityAdditive bool     `json:"set_community_additive,omitempty"`
+	SetPrependASPath     []string `json:"set_as_path_prepend,omitempty"`
+}
+
+// BgpRouteMapAttachment is the inbound and outbound route maps of a BGP connection of a gateway. The connection
+// is either an external device connection, identified by ConnectionName, or a transit peering, identified by
+// PeerGwName.
+type BgpRouteMapAttachment struct {
+	GwName           string `json:"gw_name"`
+	ConnectionName   string `json:"conn_name"`
+	PeerGwName       string `json:"peer_gw_name"`
+	InboundRouteMap  string `json:"inbound_route_map"`
+	OutboundRouteMap string `json:"outbound_route_map"`
+}
+
+var bgpCommunityRegexp = regexp.MustCompile(`^(\d+):(\d+)$`)
+
+// ValidateBgpCommunity checks that community is either a well known community or a community
+// in the ASN:value format, where both parts are 16 bit integers.
+func ValidateBgpCommunity(community string) error {
+	if Contains(BgpWellKnownCommunities, community) {
+		return nil
+	}
+	m := bgpCommunityRegexp.FindStringSubmatch(community)
+	if m == nil {
+		return fmt.Errorf("invalid BGP community %q: must be in the ASN:value format or one of %s", community, strings.Join(BgpWellKnownCommunities, ", "))
+	}
+	for _, part := range m[1:] {
+		if n, err := strconv.Atoi(part); err != nil || n > 65535 {
+			return fmt.Errorf("invalid BGP community %q: ASN and value must be between 0 and 65535", community)
+		}
+	}
+	return nil
+}
+
+// Validate checks that the rule's prefix is a valid CIDR and that 'ge' and 'le' satisfy
+// prefix length <= ge <= le <= maximum prefix length.
+func (r *BgpPrefixListRule) Validate() error {
+	_, ipNet, err := net.ParseCIDR(r.Prefix)
+	if err != nil {
+		return fmt.Errorf("rule %d: invalid prefix %q", r.Sequence, r.Prefix)
+	}
+	prefixLength, maxLength := ipNet.Mask.Size()
+	if r.GeMaskLength != 0 && (r.GeMaskLength < prefixLength || r.GeMaskLength > maxLength) {
+		return fmt.Errorf("rule %d: 'ge' must be between %d and %d, got %d", r.Sequence, prefixLength, maxLength, r.GeMaskLength)
+	}
+	if r.LeMaskLength != 0 && (r.LeMaskLength < prefixLength || r.LeMaskLength > maxLength) {
+		return fmt.Errorf("rule %d: 'le' must be between %d and %d, got %d", r.Sequence, prefixLength, maxLength, r.LeMaskLength)
+	}
+	if r.GeMaskLength != 0 && r.LeMaskLength != 0 && r.GeMaskLength > r.LeMaskLength {
+		return fmt.Errorf("rule %d: 'ge' (%d) must not be greater than 'le' (%d)", r.Sequence, r.GeMaskLength, r.LeMaskLength)
+	}
+	return nil
+}
+
+// Validate checks the rules of the prefix list and that their sequence numbers are unique.
+func (p *BgpPrefixList) Validate() error {
+	seen := make(map[int]bool)
+	for _, rule := range p.Rules {
+		if seen[rule.Sequence] {
+			return fmt.Errorf("duplicate rule sequence number %d", rule.Sequence)
+		}
+		seen[rule.Sequence] = true
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the communities of the rules of the route map and that their sequence numbers are unique.
+func (m *BgpRouteMap) Validate() error {
+	seen := make(map[int]bool)
+	for _, rule := range m.Rules {
+		if seen[rule.Sequence] {
+			return fmt.Errorf("duplicate rule sequence number %d", rule.Sequence)
+		}
+		seen[rule.Sequence] = true
+		for _, communities := range [][]string{rule.MatchCommunities, rule.SetCommunities} {
+			for _, community := range communities {
+				if err := ValidateBgpCommunity(community); err != nil {
+					return fmt.Errorf("rule %d: %v", rule.Sequence, err)
+				}
+			}
+		}
+		if rule.SetCommunityAdditive && len(rule.SetCommunities) == 0 {
+			return fmt.Errorf("rule %d: 'set_community_additive' requires 'set_communities'", rule.Sequence)
+		}
+	}
+	return nil
+}
+
+func sortBgpPrefixListRules(rules []*BgpPrefixListRule) {
+	sort.SliceStable(rules, func(i, j int) bool { return rules[i].Sequence < rules[j].Sequence })
+}
+
+func sortBgpRouteMapRules(rules []*BgpRouteMapRule) {
+	sort.SliceStable(rules, func(i, j int) bool { return rules[i].Sequence < rules[j].Sequence })
+}
+
+func bgpRouteNotFoundCheck(action, method, reason string, ret bool) error {
+	if !ret && strings.Contains(strings.ToLower(reason), "does not exist") {
+		return ErrNotFound
+	}
+	return BasicCheck(action, method, reason, ret)
+}
+
+func (c *Client) editBgpPrefixList(ctx context.Context, action string, prefixList *BgpPrefixList) error {
+	// A nil slice would be encoded as 'null' instead of '[]'
+	rules := prefixList.Rules
+	if rules == nil {
+		rules = []*BgpPrefixListRule{}
+	}
+	args, err := json.Marshal(rules)
+	if err != nil {
+		return err
+	}
+	form := map[string]string{
+		"CID":              c.CID,
+		"action":           action,
+		"prefix_list_name": prefixList.Name,
+		"description":      prefixList.Description,
+		"rules":            string(args),
+	}
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
+}
+
+func (c *Client) CreateBgpPrefixList(ctx context.Context, prefixList *BgpPrefixList) error {
+	return c.editBgpPrefixList(ctx, "add_bgp_prefix_list", prefixList)
+}
+
+func (c *Client) UpdateBgpPrefixList(ctx context.Context, prefixList *BgpPrefixList) error {
+	return c.editBgpPrefixList(ctx, "update_bgp_prefix_list", prefixList)
+}
+
+func (c *Client) GetBgpPrefixList(ctx context.Context, name string) (*BgpPrefixList, error) {
+	form := map[string]string{
+		"CID":              c.CID,
+		"action":           "get_bgp_prefix_list",
+		"prefix_list_name": name,
+	}
+
+	var data struct {
+		Results BgpPrefixList `json:"results"`
+	}
+	err := c.GetAPIContext(ctx, &data, form["action"], form, bgpRouteNotFoundCheck)
+	if err != nil {
+		return nil, err
+	}
+	sortBgpPrefixListRules(data.Results.Rules)
+	return &data.Results, nil
+}
+
+func (c *Client) DeleteBgpPrefixList(ctx context.Context, name string) error {
+	form := map[string]string{
+		"CID":              c.CID,
+		"action":           "delete_bgp_prefix_list",
+		"prefix_list_name": name,
+	}
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
+}
+
+func (c *Client) editBgpRouteMap(ctx context.Context, action string, routeMap *BgpRouteMap) error {
+	// A nil slice would be encoded as 'null' instead of '[]'
+	rules := routeMap.Rules
+	if rules == nil {
+		rules = []*BgpRouteMapRule{}
+	}
+	args, err := json.Marshal(rules)
+	if err != nil {
+		return err
+	}
+	form := map[string]string{
+		"CID":            c.CID,
+		"action":         action,
+		"route_map_name": routeMap.Name,
+		"description":    routeMap.Description,
+		"rules":          string(args),
+	}
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
+}
+
+func (c *Client) CreateBgpRouteMap(ctx context.Context, routeMap *BgpRouteMap) error {
+	return c.editBgpRouteMap(ctx, "add_bgp_route_map", routeMap)
+}
+
+func (c *Client) UpdateBgpRouteMap(ctx context.Context, routeMap *BgpRouteMap) error {
+	return c.editBgpRouteMap(ctx, "update_bgp_route_map", routeMap)
+}
+
+func (c *Client) GetBgpRouteMap(ctx context.Context, name string) (*BgpRouteMap, error) {
+	form := map[string]string{
+		"CID":            c.CID,
+		"action":         "get_bgp_route_map",
+		"route_map_name": name,
+	}
+
+	var data struct {
+		Results BgpRouteMap `json:"results"`
+	}
+	err := c.GetAPIContext(ctx, &data, form["action"], form, bgpRouteNotFoundCheck)
+	if err != nil {
+		return nil, err
+	}
+	sortBgpRouteMapRules(data.Results.Rules)
+	return &data.Results, nil
+}
+
+func (c *Client) DeleteBgpRouteMap(ctx context.Context, name string) error {
+	form := map[string]string{
+		"CID":            c.CID,
+		"action":         "delete_bgp_route_map",
+		"route_map_name": name,
+	}
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
+}
+
+func bgpRouteMapAttachmentForm(attachment *BgpRouteMapAttachment) map[string]string {
+	form := map[string]string{
+		"gateway_name": attachment.GwName,
+	}
+	if attachment.PeerGwName != "" {
+		form["peer_gateway_name"] = attachment.PeerGwName
+	} else {
+		form["connection_name"] = attachment.ConnectionName
+	}
+	return form
+}
+
+// AttachBgpRouteMap applies routeMapName to the routes received from (inbound) or advertised to (outbound)
+// the BGP neighbors of a connection. An empty routeMapName detaches the route map of the direction.
+func (c *Client) AttachBgpRouteMap(ctx context.Context, attachment *BgpRouteMapAttachment, direction, routeMapName string) error {
+	form := bgpRouteMapAttachmentForm(attachment)
+	form["CID"] = c.CID
+	form["direction"] = direction
+	if routeMapName == "" {
+		form["action"] = "detach_bgp_route_map"
+	} else {
+		form["action"] = "attach_bgp_route_map"
+		form["route_map_name"] = routeMapName
+	}
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
+}
+
+// GetBgpRouteMapAttachment returns the route maps attached to a connection.
+func (c *Client) GetBgpRouteMapAttachment(ctx context.Context, attachment *BgpRouteMapAttachment) (*BgpRouteMapAttachment, error) {
+	form := bgpRouteMapAttachmentForm(attachment)
+	form["CID"] = c.CID
+	form["action"] = "get_connection_bgp_route_maps"
+
+	var data struct {
+		Results BgpRouteMapAttachment `json:"results"`
+	}
+	err := c.GetAPIContext(ctx, &data, form["action"], form, bgpRouteNotFoundCheck)
+	if err != nil {
+		return nil, err
+	}
+	data.Results.GwName = attachment.GwName
+	data.Results.ConnectionName = attachment.ConnectionName
+	data.Results.PeerGwName = attachment.PeerGwName
+	return &data.Results, nil
+}
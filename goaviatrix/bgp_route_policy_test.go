@@ -0,0 +1,130 @@
+package goaviatrix
+
+import (
+	"testing"
+)
+
+func TestValidateBgpCommunity(t *testing.T) {
+	tt := []struct {
+		Community string
+		Valid     bool
+	}{
+		{"65001:100", true},
+		{"0:0", true},
+		{"65535:65535", true},
+		{"no-export", true},
+		{"65536:1", false},
+		{"1:65536", false},
+		{"65001", false},
+		{"65001:100:1", false},
+		{"no-export-subconfed", false},
+	}
+
+	for _, test := range tt {
+		err := ValidateBgpCommunity(test.Community)
+		if test.Valid && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.Community, err)
+		}
+		if !test.Valid && err == nil {
+			t.Errorf("%s: expected an error", test.Community)
+		}
+	}
+}
+
+func TestBgpPrefixListValidate(t *testing.T) {
+	tt := []struct {
+		Name  string
+		Rules []*BgpPrefixListRule
+		Valid bool
+	}{
+		{
+			"exact prefix",
+			[]*BgpPrefixListRule{{Sequence: 10, Action: "permit", Prefix: "10.0.0.0/8"}},
+			true,
+		},
+		{
+			"prefix length range",
+			[]*BgpPrefixListRule{{Sequence: 10, Action: "permit", Prefix: "10.0.0.0/8", GeMaskLength: 16, LeMaskLength: 24}},
+			true,
+		},
+		{
+			"ge shorter than prefix",
+			[]*BgpPrefixListRule{{Sequence: 10, Action: "permit", Prefix: "10.0.0.0/16", GeMaskLength: 8}},
+			false,
+		},
+		{
+			"le longer than address",
+			[]*BgpPrefixListRule{{Sequence: 10, Action: "permit", Prefix: "10.0.0.0/16", LeMaskLength: 33}},
+			false,
+		},
+		{
+			"ge greater than le",
+			[]*BgpPrefixListRule{{Sequence: 10, Action: "permit", Prefix: "10.0.0.0/8", GeMaskLength: 24, LeMaskLength: 16}},
+			false,
+		},
+		{
+			"invalid prefix",
+			[]*BgpPrefixListRule{{Sequence: 10, Action: "permit", Prefix: "10.0.0.0"}},
+			false,
+		},
+		{
+			"duplicate sequence",
+			[]*BgpPrefixListRule{
+				{Sequence: 10, Action: "permit", Prefix: "10.0.0.0/8"},
+				{Sequence: 10, Action: "deny", Prefix: "0.0.0.0/0"},
+			},
+			false,
+		},
+	}
+
+	for _, test := range tt {
+		prefixList := &BgpPrefixList{Name: "test", Rules: test.Rules}
+		err := prefixList.Validate()
+		if test.Valid && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.Name, err)
+		}
+		if !test.Valid && err == nil {
+			t.Errorf("%s: expected an error", test.Name)
+		}
+	}
+}
+
+func TestBgpRouteMapValidate(t *testing.T) {
+	tt := []struct {
+		Name  string
+		Rules []*BgpRouteMapRule
+		Valid bool
+	}{
+		{
+			"local preference and communities",
+			[]*BgpRouteMapRule{{Sequence: 10, Action: "permit", MatchCommunities: []string{"65001:1"}, SetLocalPreference: 200, SetCommunities: []string{"no-export"}, SetCommunityAdditive: true}},
+			true,
+		},
+		{
+			"invalid match community",
+			[]*BgpRouteMapRule{{Sequence: 10, Action: "permit", MatchCommunities: []string{"65001"}}},
+			false,
+		},
+		{
+			"additive without communities",
+			[]*BgpRouteMapRule{{Sequence: 10, Action: "permit", SetCommunityAdditive: true}},
+			false,
+		},
+		{
+			"duplicate sequence",
+			[]*BgpRouteMapRule{{Sequence: 10, Action: "permit"}, {Sequence: 10, Action: "deny"}},
+			false,
+		},
+	}
+
+	for _, test := range tt {
+		routeMap := &BgpRouteMap{Name: "test", Rules: test.Rules}
+		err := routeMap.Validate()
+		if test.Valid && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.Name, err)
+		}
+		if !test.Valid && err == nil {
+			t.Errorf("%s: expected an error", test.Name)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+)
+
+// CertificateInfo describes the first certificate of a PEM bundle.
+type CertificateInfo struct {
+	Subject   string
+	Issuer    string
+	NotBefore time.Time
+	NotAfter  time.Time
+	// SANs are the DNS names and IP addresses of the subject alternative names
+	SANs []string
+	IsCA bool
+}
+
+// ExpiresWithin returns whether the certificate is expired or expires within d of now.
+func (ci *CertificateInfo) ExpiresWithin(now time.Time, d time.Duration) bool {
+	return !now.Add(d).Before(ci.NotAfter)
+}
+
+// ParseCertificatesPEM parses all the certificates of a PEM bundle. Blocks other than certificates are ignored.
+func ParseCertificatesPEM(certPEM string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(certPEM)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse certificate %d: %v", len(certs)+1, err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+	return certs, nil
+}
+
+// GetCertificateInfo describes the first certificate of a PEM bundle.
+func GetCertificateInfo(certPEM string) (*CertificateInfo, error) {
+	certs, err := ParseCertificatesPEM(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	cert := certs[0]
+
+	var sans []string
+	sans = append(sans, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	return &CertificateInfo{
+		Subject:   cert.Subject.String(),
+		Issuer:    cert.Issuer.String(),
+		NotBefore: cert.NotBefore,
+		NotAfter:  cert.NotAfter,
+		SANs:      sans,
+		IsCA:      cert.IsCA,
+	}, nil
+}
+
+// ValidateCertificateKeyPair checks that the private key belongs to the first certificate of the PEM bundle.
+func ValidateCertificateKeyPair(certPEM, keyPEM string) error {
+	if _, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM)); err != nil {
+		return fmt.Errorf("certificate and private key don't match: %v", err)
+	}
+	return nil
+}
+
+// ValidateCACertificate checks that the first certificate of the PEM bundle is a CA certificate.
+// Self-signed version 1 certificates, which have no basic constraints, are accepted as CA certificates.
+func ValidateCACertificate(caPEM string) error {
+	certs, err := ParseCertificatesPEM(caPEM)
+	if err != nil {
+		return err
+	}
+	if !certs[0].IsCA && !(certs[0].Version < 3 && isSelfSigned(certs[0])) {
+		return fmt.Errorf("certificate %q is not a CA certificate", certs[0].Subject.String())
+	}
+	return nil
+}
+
+// ValidateCertificateChain checks that the first certificate of certPEM chains up to a self-signed
+// root certificate of caPEM at time now. The other certificates of both bundles are used as
+// intermediate certificates.
+func ValidateCertificateChain(certPEM, caPEM string, now time.Time) error {
+	certs, err := ParseCertificatesPEM(certPEM)
+	if err != nil {
+		return err
+	}
+	caCerts, err := ParseCertificatesPEM(caPEM)
+	if err != nil {
+		return fmt.Errorf("invalid CA certificate: %v", err)
+	}
+
+	roots := x509.NewCertPool()
+	intermediates := x509.NewCertPool()
+	var rootCount int
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	for _, cert := range caCerts {
+		if isSelfSigned(cert) {
+			roots.AddCert(cert)
+			rootCount++
+		} else {
+			intermediates.AddCert(cert)
+		}
+	}
+	if rootCount == 0 {
+		return fmt.Errorf("incomplete certificate chain: the CA certificate doesn't contain a self-signed root certificate")
+	}
+
+	_, err = certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("certificate %q doesn't chain up to the CA certificate: %v", certs[0].Subject.String(), err)
+	}
+	return nil
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.RawSubject, cert.RawIssuer) && cert.CheckSignatureFrom(cert) == nil
+}
+
+// FormatCertificateExpiry describes when a certificate expires relative to now.
+func FormatCertificateExpiry(name string, ci *CertificateInfo, now time.Time) string {
+	notAfter := ci.NotAfter.UTC().Format(time.RFC3339)
+	if !now.Before(ci.NotAfter) {
+		return fmt.Sprintf("%s %q expired on %s.", name, ci.Subject, notAfter)
+	}
+	return fmt.Sprintf("%s %q expires on %s, in %d day(s).", name, ci.Subject, notAfter, int(ci.NotAfter.Sub(now).Hours()/24))
+}
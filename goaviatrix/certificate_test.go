@@ -0,0 +1,182 @@
+package goaviatrix
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testV1CACertificatePEM is a self-signed version 1 certificate, which has no basic constraints
+const testV1CACertificatePEM = `-----BEGIN CERTIFICATE-----
+MIIDKjCCAhICCQDgRt4UZsINwjANBgkqhkiG9w0BAQsFADBXMQswCQYDVQQGEwJV
+UzELMAkGA1UECAwCQ0ExEjAQBgNVBAcMCUN1cGVydGlubzEOMAwGA1UECgwFQXBw
+bGUxFzAVBgNVBAMMDmN5cnVzamF2YW4uY29tMB4XDTIxMDIwMjE4NDU1M1oXDTI2
+MDIwMTE4NDU1M1owVzELMAkGA1UEBhMCVVMxCzAJBgNVBAgMAkNBMRIwEAYDVQQH
+DAlDdXBlcnRpbm8xDjAMBgNVBAoMBUFwcGxlMRcwFQYDVQQDDA5jeXJ1c2phdmFu
+LmNvbTCCASIwDQYJKoZIhvcNAQEBBQADggEPADCCAQoCggEBAJZwUvxIur3Iud0X
+h/HS5VumgC7qbjBHHpDe73+51hsoMq94t1hvN7MkNu9MoQ7iOonPReKq2+qj/ZVo
+XwZTCq2UAFH4RvtGvhnzMuJPpmNtBb33M4GPhMDVMZRm0lv1kKAzvDKNO6QZDHKJ
+R/poYNFr0CjMNNIjAMgJtMyIxyZbslQ4eNUxTtSo2SHYoYR+VK0ciK7gWlOJzmRB
+ZhQYLR9S8C2bUSylXRpFvb3apPDONf8QguvoQYUL/acw9s4mfbEP2TruQHpnfdiy
+jwbVvUzN1qsNM6Kk1nXiCkgoDclTtGuQTbeFtxhuw/yDtmzPce5IItOko9ckV0Zr
+XalGsYUCAwEAATANBgkqhkiG9w0BAQsFAAOCAQEARTwMmBLw0R+D1HRPIipf+mx1
+udKDadcjypkQpqAnTkzXngnc/+tZi7vB0EhiU3ODAUvc3dv8BGBx7XFhR03jh31+
+xWQZSAY8zVzfzwkYPlgYL7+L8TW+WT+rfkoaFF+xFCzSpCD6dKPTpzMNCHwshqua
+Tz0kEeJ6d2ZuXICGNyl0gMxnULapJjW4sDbMNeK9bl3cJPF9BsfT1nDIlNGiJ6vr
+KQZ0NERnAlm69cJiIvOx1xYKW9pw+sQHDJPouIAjFoH+eDvhZLqIrE7aZKUNFrrK
+JSbEHyZUdM+2F2xWRk1oBHmatyKdzi6vtOC0Yix+oa2iDUJfNGdsI3xKaF+oKA==
+-----END CERTIFICATE-----
+`
+
+type testCertificate struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM string
+	keyPEM  string
+}
+
+func newTestCertificate(t *testing.T, cn string, isCA bool, notAfter time.Time, parent *testCertificate) *testCertificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              notAfter,
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	if !isCA {
+		template.DNSNames = []string{cn}
+		template.IPAddresses = []net.IP{net.ParseIP("10.0.0.1")}
+	}
+
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCertificate{
+		cert:    cert,
+		key:     key,
+		certPEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		keyPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+	}
+}
+
+func TestGetCertificateInfo(t *testing.T) {
+	notAfter := time.Now().Add(90 * 24 * time.Hour).Truncate(time.Second)
+	root := newTestCertificate(t, "root", true, notAfter, nil)
+	server := newTestCertificate(t, "controller.example.com", false, notAfter, root)
+
+	info, err := GetCertificateInfo(server.certPEM + root.certPEM)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.Subject != "CN=controller.example.com" || info.Issuer != "CN=root" || info.IsCA {
+		t.Errorf("unexpected certificate info: %+v", info)
+	}
+	if !info.NotAfter.Equal(notAfter) {
+		t.Errorf("expected not after %s, got %s", notAfter, info.NotAfter)
+	}
+	if expected := []string{"controller.example.com", "10.0.0.1"}; !reflect.DeepEqual(info.SANs, expected) {
+		t.Errorf("expected SANs %v, got %v", expected, info.SANs)
+	}
+
+	if info.ExpiresWithin(time.Now(), 30*24*time.Hour) {
+		t.Errorf("certificate shouldn't expire within 30 days")
+	}
+	if !info.ExpiresWithin(time.Now(), 90*24*time.Hour) {
+		t.Errorf("certificate should expire within 90 days")
+	}
+
+	if _, err := GetCertificateInfo("not a certificate"); err == nil {
+		t.Errorf("expected an error for an invalid certificate")
+	}
+}
+
+func TestValidateCertificateKeyPair(t *testing.T) {
+	notAfter := time.Now().Add(24 * time.Hour)
+	root := newTestCertificate(t, "root", true, notAfter, nil)
+	server := newTestCertificate(t, "server", false, notAfter, root)
+
+	if err := ValidateCertificateKeyPair(server.certPEM, server.keyPEM); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := ValidateCertificateKeyPair(server.certPEM, root.keyPEM); err == nil {
+		t.Errorf("expected an error for a private key of another certificate")
+	}
+}
+
+func TestValidateCACertificate(t *testing.T) {
+	notAfter := time.Now().Add(24 * time.Hour)
+	root := newTestCertificate(t, "root", true, notAfter, nil)
+	server := newTestCertificate(t, "server", false, notAfter, root)
+
+	if err := ValidateCACertificate(root.certPEM); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := ValidateCACertificate(testV1CACertificatePEM); err != nil {
+		t.Errorf("unexpected error for a self-signed version 1 certificate: %v", err)
+	}
+	if err := ValidateCACertificate(server.certPEM); err == nil {
+		t.Errorf("expected an error for a server certificate")
+	}
+}
+
+func TestValidateCertificateChain(t *testing.T) {
+	now := time.Now()
+	notAfter := now.Add(24 * time.Hour)
+	root := newTestCertificate(t, "root", true, notAfter, nil)
+	intermediate := newTestCertificate(t, "intermediate", true, notAfter, root)
+	server := newTestCertificate(t, "server", false, notAfter, intermediate)
+	otherRoot := newTestCertificate(t, "other-root", true, notAfter, nil)
+
+	tests := []struct {
+		name    string
+		certPEM string
+		caPEM   string
+		now     time.Time
+		valid   bool
+	}{
+		{"intermediate in the CA bundle", server.certPEM, intermediate.certPEM + root.certPEM, now, true},
+		{"intermediate in the server bundle", server.certPEM + intermediate.certPEM, root.certPEM, now, true},
+		{"missing intermediate", server.certPEM, root.certPEM, now, false},
+		{"missing root", server.certPEM, intermediate.certPEM, now, false},
+		{"other root", server.certPEM + intermediate.certPEM, otherRoot.certPEM, now, false},
+		{"expired", server.certPEM + intermediate.certPEM, root.certPEM, notAfter.Add(time.Hour), false},
+	}
+	for _, test := range tests {
+		err := ValidateCertificateChain(test.certPEM, test.caPEM, test.now)
+		if test.valid && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+	}
+}
@@ -249,6 +249,12 @@ func (c *Client) PostAsyncAPI(action string, i interface{}, checkFunc CheckAPIRe
 }
 
 func (c *Client) PostAsyncAPIContext(ctx context.Context, action string, i interface{}, checkFunc CheckAPIResponseFunc) error {
+	return c.PostAsyncAPIContextWithProgress(ctx, action, i, checkFunc, nil)
+}
+
+// PostAsyncAPIContextWithProgress is PostAsyncAPIContext that also calls progress with the new lines
+// of the async task log while the task is running.
+func (c *Client) PostAsyncAPIContextWithProgress(ctx context.Context, action string, i interface{}, checkFunc CheckAPIResponseFunc, progress func(string)) error {
 	log.Printf("[DEBUG] Post AsyncAPI %s: %v", action, i)
 	resp, err := c.PostContext(ctx, c.baseURL, i)
 	if err != nil {
@@ -282,6 +288,7 @@ func (c *Client) PostAsyncAPIContext(ctx context.Context, action string, i inter
 	backendURL := fmt.Sprintf("https://%s/v1/backend1", c.ControllerIP)
 	const maxPoll = 360
 	sleepDuration := time.Second * 10
+	var reported int
 	var j int
 	for ; j < maxPoll; j++ {
 		resp, err = c.PostContext(ctx, backendURL, form)
@@ -302,6 +309,12 @@ func (c *Client) PostAsyncAPIContext(ctx context.Context, action string, i inter
 		if err != nil {
 			return fmt.Errorf("decode check_task_status failed: %v\n Body: %s", err, buf.String())
 		}
+		if progress != nil && len(data.Result) > reported {
+			if newLines := strings.TrimSpace(data.Result[reported:]); newLines != "" {
+				progress(newLines)
+			}
+			reported = len(data.Result)
+		}
 		if !data.Done {
 			// Not done yet
 			time.Sleep(sleepDuration)
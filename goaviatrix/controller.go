@@ -39,6 +39,7 @@ type CloudnBackupConfiguration struct {
 	BackupContainerName string `json:"container_name"`
 	BackupRegion        string `json:"region"`
 	MultipleBackups     string `json:"multiple_bkup,omitempty"`
+	LastBackupTime      string `json:"last_backup_time,omitempty"`
 }
 
 type GetCloudnBackupConfigResp struct {
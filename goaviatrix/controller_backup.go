@@ -0,0 +1,106 @@
+package goaviatrix
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+type ControllerBackup struct {
+	FileName  string `json:"file_name"`
+	Size      int64  `json:"size"`
+	CreatedAt string `json:"create_time"`
+}
+
+// CreatedTime parses the creation time of the backup.
+func (b ControllerBackup) CreatedTime() (time.Time, error) {
+	t, err := ParseControllerTime(b.CreatedAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid creation time %q for controller backup %s", b.CreatedAt, b.FileName)
+	}
+	return t, nil
+}
+
+// ControllerRestore is a controller backup to restore and the location it is stored in.
+type ControllerRestore struct {
+	CloudType     int
+	AccountName   string
+	BucketName    string
+	StorageName   string
+	ContainerName string
+	Region        string
+	FileName      string
+}
+
+// CreateControllerBackup backs up the controller to the configured backup location now and returns
+// the file name of the backup.
+func (c *Client) CreateControllerBackup(ctx context.Context) (string, error) {
+	form := map[string]string{
+		"CID":    c.CID,
+		"action": "backup_cloudn_now",
+	}
+
+	var data struct {
+		Results struct {
+			FileName string `json:"file_name"`
+		} `json:"results"`
+	}
+	err := c.PostAPIContextWithResponse(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return "", err
+	}
+	if data.Results.FileName == "" {
+		return "", fmt.Errorf("no backup file name returned by the controller")
+	}
+	return data.Results.FileName, nil
+}
+
+// ListControllerBackups lists the controller backups in the configured backup location, newest first.
+func (c *Client) ListControllerBackups(ctx context.Context) ([]ControllerBackup, error) {
+	form := map[string]string{
+		"CID":    c.CID,
+		"action": "list_cloudn_backups",
+	}
+
+	var data struct {
+		Results []ControllerBackup `json:"results"`
+	}
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	SortControllerBackups(data.Results)
+	return data.Results, nil
+}
+
+// RestoreControllerBackup restores the controller from a backup. All the configuration of the
+// controller is replaced by the configuration of the backup.
+func (c *Client) RestoreControllerBackup(ctx context.Context, restore *ControllerRestore) error {
+	form := map[string]string{
+		"CID":            c.CID,
+		"action":         "restore_cloudn",
+		"cloud_type":     strconv.Itoa(restore.CloudType),
+		"account_name":   restore.AccountName,
+		"bucket_name":    restore.BucketName,
+		"storage_name":   restore.StorageName,
+		"container_name": restore.ContainerName,
+		"region":         restore.Region,
+		"file_name":      restore.FileName,
+		"async":          "true",
+	}
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
+}
+
+// SortControllerBackups sorts the backups newest first. Backups with an invalid creation time are sorted last.
+func SortControllerBackups(backups []ControllerBackup) {
+	sort.SliceStable(backups, func(i, j int) bool {
+		ti, erri := backups[i].CreatedTime()
+		tj, errj := backups[j].CreatedTime()
+		if erri != nil || errj != nil {
+			return erri == nil && errj != nil
+		}
+		return ti.After(tj)
+	})
+}
@@ -0,0 +1,35 @@
+package goaviatrix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortControllerBackups(t *testing.T) {
+	backups := []ControllerBackup{
+		{FileName: "backup-1", CreatedAt: "2022-10-18 06:00:00"},
+		{FileName: "backup-unknown", CreatedAt: ""},
+		{FileName: "backup-3", CreatedAt: "2022-10-20T06:00:00Z"},
+		{FileName: "backup-2", CreatedAt: "2022-10-19 06:00:00"},
+	}
+
+	SortControllerBackups(backups)
+
+	var fileNames []string
+	for _, backup := range backups {
+		fileNames = append(fileNames, backup.FileName)
+	}
+	expected := []string{"backup-3", "backup-2", "backup-1", "backup-unknown"}
+	if !reflect.DeepEqual(fileNames, expected) {
+		t.Fatalf("expected %v, got %v", expected, fileNames)
+	}
+}
+
+func TestControllerBackupCreatedTime(t *testing.T) {
+	if _, err := (ControllerBackup{FileName: "backup", CreatedAt: "2022-10-20 06:00:00"}).CreatedTime(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := (ControllerBackup{FileName: "backup", CreatedAt: "yesterday"}).CreatedTime(); err == nil {
+		t.Fatalf("expected an error for an invalid creation time")
+	}
+}
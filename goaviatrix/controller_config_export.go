@@ -0,0 +1,433 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Sections of the controller configuration export
+const (
+	ControllerConfigExportAccounts                       = "accounts"
+	ControllerConfigExportGateways                       = "gateways"
+	ControllerConfigExportTransitGatewayPeerings         = "transit_gateway_peerings"
+	ControllerConfigExportNetworkDomains                 = "network_domains"
+	ControllerConfigExportDistributedFirewallingPolicies = "distributed_firewalling_policies"
+	ControllerConfigExportLogging                        = "logging"
+)
+
+var ControllerConfigExportSections = []string{
+	ControllerConfigExportAccounts,
+	ControllerConfigExportGateways,
+	ControllerConfigExportTransitGatewayPeerings,
+	ControllerConfigExportNetworkDomains,
+	ControllerConfigExportDistributedFirewallingPolicies,
+	ControllerConfigExportLogging,
+}
+
+// remoteSyslogServerCount is the number of remote syslog servers a controller supports
+const remoteSyslogServerCount = 10
+
+// ExportedAccount is an access account without its credentials.
+type ExportedAccount struct {
+	AccountName string `json:"account_name"`
+	CloudType   int    `json:"cloud_type"`
+	AccountID   string `json:"account_id"`
+}
+
+type ExportedGateway struct {
+	GwName                       string   `json:"gw_name"`
+	CloudType                    int      `json:"cloud_type"`
+	AccountName                  string   `json:"account_name"`
+	VpcID                        string   `json:"vpc_id"`
+	VpcRegion                    string   `json:"vpc_region"`
+	GwSize                       string   `json:"gw_size"`
+	Subnet                       string   `json:"subnet"`
+	PrivateIP                    string   `json:"private_ip"`
+	PublicIP                     string   `json:"public_ip"`
+	SoftwareVersion              string   `json:"software_version"`
+	ImageVersion                 string   `json:"image_version"`
+	IsHa                         bool     `json:"is_ha"`
+	IsTransit                    bool     `json:"is_transit"`
+	IsSpoke                      bool     `json:"is_spoke"`
+	TransitGwName                string   `json:"transit_gw_name"`
+	LocalASNumber                string   `json:"local_as_number"`
+	EnableNat                    bool     `json:"enable_nat"`
+	SingleAZ                     bool     `json:"single_az_ha"`
+	EnableVpcDnsServer           bool     `json:"enable_vpc_dns_server"`
+	EnableEncryptVolume          bool     `json:"enable_encrypt_volume"`
+	ConnectedTransit             bool     `json:"connected_transit"`
+	EnableLearnedCidrsApproval   bool     `json:"enable_learned_cidrs_approval"`
+	BgpManualSpokeAdvertiseCidrs []string `json:"bgp_manual_spoke_advertise_cidrs"`
+}
+
+type ExportedTransitGatewayPeering struct {
+	TransitGatewayName1 string `json:"transit_gateway_name1"`
+	TransitGatewayName2 string `json:"transit_gateway_name2"`
+}
+
+type ExportedNetworkDomain struct {
+	Name                         string `json:"name"`
+	TgwName                      string `json:"tgw_name"`
+	Account                      string `json:"account"`
+	Region                       string `json:"region"`
+	IntraDomainInspectionEnabled bool   `json:"intra_domain_inspection"`
+	IntraDomainInspectionName    string `json:"intra_domain_inspection_name"`
+	EgressInspection             bool   `json:"egress_inspection"`
+	EgressInspectionName         string `json:"egress_inspection_name"`
+	InspectionPolicy             string `json:"inspection_policy"`
+	Type                         string `json:"type"`
+}
+
+// ExportedLoggingIntegration is an enabled logging integration without its secrets, such as API keys.
+type ExportedLoggingIntegration struct {
+	Name             string            `json:"name"`
+	Server           string            `json:"server"`
+	Port             string            `json:"port"`
+	Settings         map[string]string `json:"settings"`
+	ExcludedGateways []string          `json:"excluded_gateways"`
+}
+
+// ExportControllerConfig exports the given sections of the controller configuration. The exported
+// lists are sorted, so that exports of the same configuration are equal.
+func (c *Client) ExportControllerConfig(ctx context.Context, sections []string) (map[string]interface{}, error) {
+	export := make(map[string]interface{})
+	for _, section := range sections {
+		var v interface{}
+		var err error
+		switch section {
+		case ControllerConfigExportAccounts:
+			v, err = c.exportAccounts()
+		case ControllerConfigExportGateways:
+			v, err = c.exportGateways(ctx)
+		case ControllerConfigExportTransitGatewayPeerings:
+			v, err = c.exportTransitGatewayPeerings(ctx)
+		case ControllerConfigExportNetworkDomains:
+			v, err = c.exportNetworkDomains(ctx)
+		case ControllerConfigExportDistributedFirewallingPolicies:
+			v, err = c.exportDistributedFirewallingPolicies(ctx)
+		case ControllerConfigExportLogging:
+			v, err = c.exportLogging()
+		default:
+			return nil, fmt.Errorf("unknown controller configuration export section %q", section)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not export %s: %w", section, err)
+		}
+		export[section] = v
+	}
+	return export, nil
+}
+
+// CanonicalJSON encodes v as indented JSON with the keys of all objects sorted.
+func CanonicalJSON(v interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	// Decoding into interface{} turns structs into maps, which are encoded with sorted keys
+	var generic interface{}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(generic, "", "  ")
+}
+
+// ListTransitGatewayPeerings lists all the transit gateway peerings of the controller.
+func (c *Client) ListTransitGatewayPeerings(ctx context.Context) ([]TransitGatewayPeering, error) {
+	form := map[string]string{
+		"CID":    c.CID,
+		"action": "list_inter_transit_gateway_peering",
+	}
+
+	var data TransitGatewayPeeringAPIResp
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	var peerings []TransitGatewayPeering
+	for i := range data.Results {
+		peerings = append(peerings, data.Results[i]...)
+	}
+	return peerings, nil
+}
+
+func (c *Client) exportAccounts() ([]ExportedAccount, error) {
+	accounts, err := c.ListAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	exported := []ExportedAccount{}
+	for _, account := range accounts {
+		exported = append(exported, ExportedAccount{
+			AccountName: account.AccountName,
+			CloudType:   account.CloudType,
+			AccountID:   exportedAccountID(account),
+		})
+	}
+	sort.Slice(exported, func(i, j int) bool {
+		return exported[i].AccountName < exported[j].AccountName
+	})
+	return exported, nil
+}
+
+// exportedAccountID returns the identifier of the cloud account, project or tenancy of an access account.
+func exportedAccountID(account Account) string {
+	switch account.CloudType {
+	case AWS:
+		return account.AwsAccountNumber
+	case AWSGov:
+		return account.AwsgovAccountNumber
+	case AWSChina:
+		return account.AwsChinaAccountNumber
+	case AWSTS:
+		return account.AwsTsAccountNumber
+	case AWSS:
+		return account.AwsSAccountNumber
+	case Azure:
+		return account.ArmSubscriptionId
+	case AzureGov:
+		return account.AzuregovSubscriptionId
+	case AzureChina:
+		return account.AzureChinaSubscriptionId
+	case GCP:
+		return account.GcloudProjectName
+	case OCI:
+		return account.OciTenancyID
+	case AliCloud:
+		return account.AlicloudAccountId
+	}
+	return ""
+}
+
+func (c *Client) exportGateways(ctx context.Context) ([]ExportedGateway, error) {
+	gateways, err := c.GetGatewayList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exported := []ExportedGateway{}
+	for _, gw := range gateways {
+		exported = append(exported, ExportedGateway{
+			GwName:                       gw.GwName,
+			CloudType:                    gw.CloudType,
+			AccountName:                  gw.AccountName,
+			VpcID:                        gw.VpcID,
+			VpcRegion:                    gw.VpcRegion,
+			GwSize:                       gw.GwSize,
+			Subnet:                       gw.VpcNet,
+			PrivateIP:                    gw.PrivateIP,
+			PublicIP:                     gw.PublicIP,
+			SoftwareVersion:              gw.SoftwareVersion,
+			ImageVersion:                 gw.ImageVersion,
+			IsHa:                         gw.IsHagw == "yes",
+			IsTransit:                    gw.TransitVpc == "yes",
+			IsSpoke:                      gw.SpokeVpc == "yes",
+			TransitGwName:                gw.TransitGwName,
+			LocalASNumber:                gw.LocalASNumber,
+			EnableNat:                    gw.EnableNat == "yes",
+			SingleAZ:                     gw.SingleAZ == "yes",
+			EnableVpcDnsServer:           gw.EnableVpcDnsServer == "Enabled",
+			EnableEncryptVolume:          gw.EnableEncryptVolume,
+			ConnectedTransit:             gw.ConnectedTransit == "yes",
+			EnableLearnedCidrsApproval:   gw.EnableLearnedCidrsApproval,
+			BgpManualSpokeAdvertiseCidrs: sortedStrings(gw.BgpManualSpokeAdvertiseCidrs),
+		})
+	}
+	sort.Slice(exported, func(i, j int) bool {
+		return exported[i].GwName < exported[j].GwName
+	})
+	return exported, nil
+}
+
+func (c *Client) exportTransitGatewayPeerings(ctx context.Context) ([]ExportedTransitGatewayPeering, error) {
+	peerings, err := c.ListTransitGatewayPeerings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return normalizeTransitGatewayPeerings(peerings), nil
+}
+
+// normalizeTransitGatewayPeerings orders the gateways of each peering by name, removes duplicate
+// peerings and sorts the peerings.
+func normalizeTransitGatewayPeerings(peerings []TransitGatewayPeering) []ExportedTransitGatewayPeering {
+	seen := make(map[ExportedTransitGatewayPeering]bool)
+	normalized := []ExportedTransitGatewayPeering{}
+	for _, peering := range peerings {
+		p := ExportedTransitGatewayPeering{
+			TransitGatewayName1: peering.TransitGatewayName1,
+			TransitGatewayName2: peering.TransitGatewayName2,
+		}
+		if p.TransitGatewayName1 > p.TransitGatewayName2 {
+			p.TransitGatewayName1, p.TransitGatewayName2 = p.TransitGatewayName2, p.TransitGatewayName1
+		}
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+		normalized = append(normalized, p)
+	}
+	sort.Slice(normalized, func(i, j int) bool {
+		if normalized[i].TransitGatewayName1 != normalized[j].TransitGatewayName1 {
+			return normalized[i].TransitGatewayName1 < normalized[j].TransitGatewayName1
+		}
+		return normalized[i].TransitGatewayName2 < normalized[j].TransitGatewayName2
+	})
+	return normalized
+}
+
+func (c *Client) exportNetworkDomains(ctx context.Context) ([]ExportedNetworkDomain, error) {
+	domains, err := c.GetAllNetworkDomains(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exported := []ExportedNetworkDomain{}
+	for _, domain := range domains {
+		exported = append(exported, ExportedNetworkDomain{
+			Name:                         domain.Name,
+			TgwName:                      domain.TgwName,
+			Account:                      domain.Account,
+			Region:                       domain.Region,
+			IntraDomainInspectionEnabled: domain.IntraDomainInspectionEnabled,
+			IntraDomainInspectionName:    domain.IntraDomainInspectionName,
+			EgressInspection:             domain.EgressInspection,
+			EgressInspectionName:         domain.EgressInspectionName,
+			InspectionPolicy:             domain.InspectionPolicy,
+			Type:                         domain.Type,
+		})
+	}
+	sort.Slice(exported, func(i, j int) bool {
+		if exported[i].TgwName != exported[j].TgwName {
+			return exported[i].TgwName < exported[j].TgwName
+		}
+		return exported[i].Name < exported[j].Name
+	})
+	return exported, nil
+}
+
+func (c *Client) exportDistributedFirewallingPolicies(ctx context.Context) ([]MicrosegPolicy, error) {
+	policyList, err := c.GetMicrosegPolicyList(ctx)
+	if err == ErrNotFound {
+		return []MicrosegPolicy{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The order of the policies is given by their priority
+	policies := policyList.Policies
+	for i := range policies {
+		policies[i].SrcAppDomains = sortedStrings(policies[i].SrcAppDomains)
+		policies[i].DstAppDomains = sortedStrings(policies[i].DstAppDomains)
+	}
+	sort.SliceStable(policies, func(i, j int) bool {
+		if policies[i].Priority != policies[j].Priority {
+			return policies[i].Priority < policies[j].Priority
+		}
+		return policies[i].Name < policies[j].Name
+	})
+	return policies, nil
+}
+
+func (c *Client) exportLogging() ([]ExportedLoggingIntegration, error) {
+	exported := []ExportedLoggingIntegration{}
+	add := func(name, server, port string, settings map[string]string, excludedGateways []string) {
+		exported = append(exported, ExportedLoggingIntegration{
+			Name:             name,
+			Server:           server,
+			Port:             port,
+			Settings:         settings,
+			ExcludedGateways: sortedStrings(excludedGateways),
+		})
+	}
+
+	for i := 0; i < remoteSyslogServerCount; i++ {
+		r, err := c.GetRemoteSyslogStatus(i)
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("remote syslog %d: %w", i, err)
+		}
+		add(fmt.Sprintf("remote_syslog_%d", i), r.Server, r.Port, map[string]string{
+			"name":     r.Name,
+			"protocol": r.Protocol,
+			"template": r.Template,
+			"notls":    fmt.Sprint(r.Notls),
+		}, r.ExcludedGateways)
+	}
+
+	if r, err := c.GetSplunkLoggingStatus(); err == nil {
+		add("splunk", r.Server, r.Port, map[string]string{
+			"custom_config": r.CustomConfig,
+		}, r.ExcludedGateways)
+	} else if err != ErrNotFound {
+		return nil, fmt.Errorf("splunk: %w", err)
+	}
+
+	if r, err := c.GetFilebeatForwarderStatus(); err == nil {
+		add("filebeat", r.Server, r.Port, map[string]string{}, r.ExcludedGateways)
+	} else if err != ErrNotFound {
+		return nil, fmt.Errorf("filebeat: %w", err)
+	}
+
+	if r, err := c.GetSumologicForwarderStatus(); err == nil {
+		add("sumologic", "", "", map[string]string{
+			"access_id":       r.AccessID,
+			"source_category": r.SourceCategory,
+			"custom_config":   r.CustomConfig,
+		}, r.ExcludedGateways)
+	} else if err != ErrNotFound {
+		return nil, fmt.Errorf("sumologic: %w", err)
+	}
+
+	if r, err := c.GetDatadogAgentStatus(); err == nil {
+		add("datadog", "", "", map[string]string{
+			"site":         r.Site,
+			"metrics_only": fmt.Sprint(r.MetricsOnly),
+		}, r.ExcludedGateways)
+	} else if err != ErrNotFound {
+		return nil, fmt.Errorf("datadog: %w", err)
+	}
+
+	if r, err := c.GetNetflowAgentStatus(); err == nil {
+		add("netflow", r.ServerIp, r.Port, map[string]string{
+			"version": r.Version,
+		}, r.ExcludedGateways)
+	} else if err != ErrNotFound {
+		return nil, fmt.Errorf("netflow: %w", err)
+	}
+
+	if r, err := c.GetCloudwatchAgentStatus(); err == nil {
+		add("cloudwatch", "", "", map[string]string{
+			"role_arn":       r.RoleArn,
+			"region":         r.Region,
+			"log_group_name": r.LogGroupName,
+		}, r.ExcludedGateways)
+	} else if err != ErrNotFound {
+		return nil, fmt.Errorf("cloudwatch: %w", err)
+	}
+
+	return exported, nil
+}
+
+// sortedStrings returns a sorted copy of s without empty strings. It never returns nil, so that
+// empty lists are exported as [] rather than null.
+func sortedStrings(s []string) []string {
+	sorted := []string{}
+	for _, v := range s {
+		if v = strings.TrimSpace(v); v != "" {
+			sorted = append(sorted, v)
+		}
+	}
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,82 @@
+package goaviatrix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	type item struct {
+		Zeta  string `json:"zeta"`
+		Alpha int    `json:"alpha"`
+	}
+	v := map[string]interface{}{
+		"b": []item{{Zeta: "z", Alpha: 1}},
+		"a": map[string]string{"y": "1", "x": "2"},
+	}
+
+	expected := `{
+  "a": {
+    "x": "2",
+    "y": "1"
+  },
+  "b": [
+    {
+      "alpha": 1,
+      "zeta": "z"
+    }
+  ]
+}`
+	for i := 0; i < 3; i++ {
+		b, err := CanonicalJSON(v)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(b) != expected {
+			t.Fatalf("expected %s, got %s", expected, b)
+		}
+	}
+}
+
+func TestNormalizeTransitGatewayPeerings(t *testing.T) {
+	peerings := []TransitGatewayPeering{
+		{TransitGatewayName1: "transit-c", TransitGatewayName2: "transit-a"},
+		{TransitGatewayName1: "transit-a", TransitGatewayName2: "transit-b"},
+		{TransitGatewayName1: "transit-a", TransitGatewayName2: "transit-c"},
+	}
+
+	expected := []ExportedTransitGatewayPeering{
+		{TransitGatewayName1: "transit-a", TransitGatewayName2: "transit-b"},
+		{TransitGatewayName1: "transit-a", TransitGatewayName2: "transit-c"},
+	}
+	if got := normalizeTransitGatewayPeerings(peerings); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestExportedAccountID(t *testing.T) {
+	tests := []struct {
+		account  Account
+		expected string
+	}{
+		{Account{CloudType: AWS, AwsAccountNumber: "123456789012", AwsSecretKey: "secret"}, "123456789012"},
+		{Account{CloudType: Azure, ArmSubscriptionId: "subscription", ArmApplicationClientSecret: "secret"}, "subscription"},
+		{Account{CloudType: GCP, GcloudProjectName: "project"}, "project"},
+		{Account{CloudType: OCI, OciTenancyID: "tenancy"}, "tenancy"},
+	}
+	for _, test := range tests {
+		if got := exportedAccountID(test.account); got != test.expected {
+			t.Errorf("cloud type %d: expected %q, got %q", test.account.CloudType, test.expected, got)
+		}
+	}
+}
+
+func TestSortedStrings(t *testing.T) {
+	if got := sortedStrings(nil); got == nil || len(got) != 0 {
+		t.Fatalf("expected an empty list, got %v", got)
+	}
+	expected := []string{"gw-a", "gw-b"}
+	if got := sortedStrings([]string{"gw-b", "", "gw-a "}); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,290 @@
+package goaviatrix
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Controller upgrade pre-flight check names
+const (
+	ControllerUpgradeCheckUpgradePath   = "upgrade_path"
+	ControllerUpgradeCheckGatewayImages = "gateway_image_compatibility"
+	ControllerUpgradeCheckDiskSpace     = "disk_space"
+	ControllerUpgradeCheckBackup        = "backup"
+)
+
+var ControllerUpgradeCheckNames = []string{
+	ControllerUpgradeCheckUpgradePath,
+	ControllerUpgradeCheckGatewayImages,
+	ControllerUpgradeCheckDiskSpace,
+	ControllerUpgradeCheckBackup,
+}
+
+const (
+	DefaultControllerUpgradeMinFreeDiskGB = 4.0
+	DefaultControllerUpgradeMaxBackupAge  = 24 * time.Hour
+)
+
+type ControllerUpgradeCheck struct {
+	Name    string
+	Passed  bool
+	Message string
+}
+
+type ControllerDiskUsage struct {
+	TotalGB float64 `json:"total_gb"`
+	FreeGB  float64 `json:"free_gb"`
+}
+
+type ControllerUpgradePreflightOptions struct {
+	TargetVersion string
+	MinFreeDiskGB float64
+	MaxBackupAge  time.Duration
+}
+
+type ControllerUpgradePreflight struct {
+	CurrentVersion string
+	TargetVersion  string
+	Checks         []ControllerUpgradeCheck
+}
+
+// FailedChecks returns the checks that failed, except for the overridden ones.
+func (p *ControllerUpgradePreflight) FailedChecks(overrides []string) []ControllerUpgradeCheck {
+	var failed []ControllerUpgradeCheck
+	for _, check := range p.Checks {
+		if !check.Passed && !Contains(overrides, check.Name) {
+			failed = append(failed, check)
+		}
+	}
+	return failed
+}
+
+func (c *Client) GetControllerDiskUsage(ctx context.Context) (*ControllerDiskUsage, error) {
+	form := map[string]string{
+		"CID":    c.CID,
+		"action": "get_controller_disk_usage",
+	}
+
+	var data struct {
+		Results ControllerDiskUsage `json:"results"`
+	}
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	return &data.Results, nil
+}
+
+// RunControllerUpgradePreflight runs the controller upgrade pre-flight checks without upgrading the controller.
+// An error is only returned if the controller version can't be read, failures of individual checks are
+// reported in the checks.
+func (c *Client) RunControllerUpgradePreflight(ctx context.Context, opts ControllerUpgradePreflightOptions) (*ControllerUpgradePreflight, error) {
+	if opts.MinFreeDiskGB == 0 {
+		opts.MinFreeDiskGB = DefaultControllerUpgradeMinFreeDiskGB
+	}
+	if opts.MaxBackupAge == 0 {
+		opts.MaxBackupAge = DefaultControllerUpgradeMaxBackupAge
+	}
+
+	versionInfo, err := c.GetVersionInfo()
+	if err != nil {
+		return nil, fmt.Errorf("could not get controller version: %v", err)
+	}
+	latestVersion, err := c.GetLatestVersion()
+	if err != nil {
+		return nil, fmt.Errorf("could not get latest controller version: %v", err)
+	}
+
+	preflight := &ControllerUpgradePreflight{
+		CurrentVersion: versionInfo.Current.String(versionInfo.Current.HasBuild),
+		TargetVersion:  opts.TargetVersion,
+	}
+	if preflight.TargetVersion == "" || preflight.TargetVersion == "latest" {
+		preflight.TargetVersion = latestVersion
+	}
+
+	preflight.Checks = append(preflight.Checks, CheckControllerUpgradePath(preflight.CurrentVersion, preflight.TargetVersion, latestVersion))
+	preflight.Checks = append(preflight.Checks, c.checkGatewayImageCompatibility(ctx, preflight.TargetVersion))
+
+	diskUsage, err := c.GetControllerDiskUsage(ctx)
+	if err != nil {
+		preflight.Checks = append(preflight.Checks, ControllerUpgradeCheck{
+			Name:    ControllerUpgradeCheckDiskSpace,
+			Message: fmt.Sprintf("could not get controller disk usage: %v", err),
+		})
+	} else {
+		preflight.Checks = append(preflight.Checks, CheckControllerDiskSpace(diskUsage, opts.MinFreeDiskGB))
+	}
+
+	backupConfig, err := c.GetCloudnBackupConfig()
+	if err != nil {
+		preflight.Checks = append(preflight.Checks, ControllerUpgradeCheck{
+			Name:    ControllerUpgradeCheckBackup,
+			Message: fmt.Sprintf("could not get controller backup config: %v", err),
+		})
+	} else {
+		preflight.Checks = append(preflight.Checks, CheckControllerBackup(backupConfig, time.Now(), opts.MaxBackupAge))
+	}
+
+	return preflight, nil
+}
+
+// ControllerOnVersion returns whether the controller is already on targetVersion, in which case there is nothing
+// to upgrade. An empty or 'latest' target version is resolved to the latest available version.
+func (c *Client) ControllerOnVersion(targetVersion string) (bool, error) {
+	versionInfo, err := c.GetVersionInfo()
+	if err != nil {
+		return false, fmt.Errorf("could not get controller version: %v", err)
+	}
+	if targetVersion == "" || targetVersion == "latest" {
+		targetVersion, err = c.GetLatestVersion()
+		if err != nil {
+			return false, fmt.Errorf("could not get latest controller version: %v", err)
+		}
+	}
+	return VersionMatches(versionInfo.Current, targetVersion), nil
+}
+
+// VersionMatches returns whether current is targetVersion. A target version without a build matches any
+// build of its release.
+func VersionMatches(current *AviatrixVersion, targetVersion string) bool {
+	_, target, err := ParseVersion(targetVersion)
+	if err != nil || targetVersion == "" {
+		return false
+	}
+	return current.String(target.HasBuild) == target.String(target.HasBuild)
+}
+
+// CheckControllerUpgradePath checks that the target version is not older than the current version,
+// is available, and doesn't skip a release. The controller can only be upgraded to a newer build of
+// its release or to the next release, e.g. from 6.8 to 6.9 but not from 6.7 to 6.9. Since the last
+// release of a major version isn't known, any release can be upgraded to the first release of the
+// next major version.
+func CheckControllerUpgradePath(currentVersion, targetVersion, latestVersion string) ControllerUpgradeCheck {
+	check := ControllerUpgradeCheck{Name: ControllerUpgradeCheckUpgradePath}
+
+	_, current, err := ParseVersion(currentVersion)
+	if err != nil {
+		check.Message = fmt.Sprintf("invalid current version %q", currentVersion)
+		return check
+	}
+	_, target, err := ParseVersion(targetVersion)
+	if err != nil || targetVersion == "" {
+		check.Message = fmt.Sprintf("invalid target version %q", targetVersion)
+		return check
+	}
+
+	cmp, _ := CompareSoftwareVersions(targetVersion, currentVersion)
+	switch {
+	case cmp < 0:
+		check.Message = fmt.Sprintf("target version %s is older than the current version %s", targetVersion, currentVersion)
+		return check
+	case cmp == 0:
+		check.Passed = true
+		check.Message = fmt.Sprintf("controller is already on version %s", currentVersion)
+		return check
+	}
+
+	if latestVersion != "" {
+		if cmp, err := CompareSoftwareVersions(targetVersion, latestVersion); err == nil && cmp > 0 {
+			check.Message = fmt.Sprintf("target version %s is newer than the latest available version %s", targetVersion, latestVersion)
+			return check
+		}
+	}
+
+	sameRelease := target.Major == current.Major && target.Minor == current.Minor
+	nextMinorRelease := target.Major == current.Major && target.Minor == current.Minor+1
+	nextMajorRelease := target.Major == current.Major+1 && target.Minor == 0
+	if !sameRelease && !nextMinorRelease && !nextMajorRelease {
+		check.Message = fmt.Sprintf("upgrading from %s to %s skips a release, the controller must be upgraded "+
+			"one release at a time", current.String(false), target.String(false))
+		return check
+	}
+
+	check.Passed = true
+	check.Message = fmt.Sprintf("controller can be upgraded from %s to %s", currentVersion, targetVersion)
+	return check
+}
+
+// CheckControllerDiskSpace checks that the controller has at least minFreeGB of free disk space.
+func CheckControllerDiskSpace(usage *ControllerDiskUsage, minFreeGB float64) ControllerUpgradeCheck {
+	check := ControllerUpgradeCheck{Name: ControllerUpgradeCheckDiskSpace}
+	if usage.FreeGB < minFreeGB {
+		check.Message = fmt.Sprintf("controller has %.1f GB of free disk space, at least %.1f GB is required", usage.FreeGB, minFreeGB)
+		return check
+	}
+	check.Passed = true
+	check.Message = fmt.Sprintf("controller has %.1f GB of free disk space", usage.FreeGB)
+	return check
+}
+
+// CheckControllerBackup checks that controller backups are enabled and that the last backup is not older than maxAge.
+func CheckControllerBackup(config *CloudnBackupConfiguration, now time.Time, maxAge time.Duration) ControllerUpgradeCheck {
+	check := ControllerUpgradeCheck{Name: ControllerUpgradeCheckBackup}
+	if config.BackupConfiguration != "yes" {
+		check.Message = "controller backups are not enabled"
+		return check
+	}
+	if config.LastBackupTime == "" {
+		check.Message = "controller has no backup"
+		return check
+	}
+
+	lastBackup, err := ParseControllerTime(config.LastBackupTime)
+	if err != nil {
+		check.Message = fmt.Sprintf("invalid last backup time %q", config.LastBackupTime)
+		return check
+	}
+
+	if age := now.Sub(lastBackup); age > maxAge {
+		check.Message = fmt.Sprintf("last controller backup is from %s, %s ago, which is older than %s",
+			config.LastBackupTime, age.Round(time.Minute), maxAge)
+		return check
+	}
+	check.Passed = true
+	check.Message = fmt.Sprintf("last controller backup is from %s", config.LastBackupTime)
+	return check
+}
+
+// checkGatewayImageCompatibility checks that a compatible gateway image exists for the target version
+// in every cloud with gateways.
+func (c *Client) checkGatewayImageCompatibility(ctx context.Context, targetVersion string) ControllerUpgradeCheck {
+	check := ControllerUpgradeCheck{Name: ControllerUpgradeCheckGatewayImages}
+
+	gateways, err := c.GetGatewayList(ctx)
+	if err != nil {
+		check.Message = fmt.Sprintf("could not list gateways: %v", err)
+		return check
+	}
+
+	cloudTypes := make(map[int]bool)
+	for _, gw := range gateways {
+		cloudTypes[gw.CloudType] = true
+	}
+	var sortedCloudTypes []int
+	for cloudType := range cloudTypes {
+		sortedCloudTypes = append(sortedCloudTypes, cloudType)
+	}
+	sort.Ints(sortedCloudTypes)
+
+	var incompatible []string
+	for _, cloudType := range sortedCloudTypes {
+		imageVersion, err := c.GetCompatibleImageVersion(ctx, cloudType, targetVersion)
+		if err != nil {
+			incompatible = append(incompatible, fmt.Sprintf("cloud type %d: %v", cloudType, err))
+		} else if imageVersion == "" {
+			incompatible = append(incompatible, fmt.Sprintf("cloud type %d: no compatible image", cloudType))
+		}
+	}
+	if len(incompatible) != 0 {
+		check.Message = fmt.Sprintf("no compatible gateway image for version %s in %s", targetVersion, strings.Join(incompatible, "; "))
+		return check
+	}
+
+	check.Passed = true
+	check.Message = fmt.Sprintf("compatible gateway images exist for %d gateways", len(gateways))
+	return check
+}
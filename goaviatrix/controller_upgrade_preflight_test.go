@@ -0,0 +1,119 @@
+package goaviatrix
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCheckControllerUpgradePath(t *testing.T) {
+	tt := []struct {
+		Name           string
+		CurrentVersion string
+		TargetVersion  string
+		LatestVersion  string
+		Expected       bool
+	}{
+		{"newer build", "6.9.221", "6.9.349", "7.0", true},
+		{"next minor release", "6.8.1149", "6.9", "7.0", true},
+		{"next major release", "6.9.349", "7.0", "7.0", true},
+		{"same version", "7.0.1373", "7.0.1373", "7.0", true},
+		{"skipped major release", "5.4.1290", "7.0", "7.0", false},
+		{"skipped minor release", "6.7.1506", "6.9", "7.0", false},
+		{"older version", "7.0.1373", "6.9", "7.0", false},
+		{"newer than latest", "6.9.349", "7.0", "6.9", false},
+		{"invalid target", "6.9.349", "", "7.0", false},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			check := CheckControllerUpgradePath(test.CurrentVersion, test.TargetVersion, test.LatestVersion)
+			if check.Name != ControllerUpgradeCheckUpgradePath {
+				t.Fatalf("expected check %s, got %s", ControllerUpgradeCheckUpgradePath, check.Name)
+			}
+			if check.Passed != test.Expected {
+				t.Fatalf("expected passed=%t, got %t: %s", test.Expected, check.Passed, check.Message)
+			}
+		})
+	}
+}
+
+func TestCheckControllerDiskSpace(t *testing.T) {
+	if check := CheckControllerDiskSpace(&ControllerDiskUsage{TotalGB: 64, FreeGB: 10}, 4); !check.Passed {
+		t.Fatalf("expected check to pass: %s", check.Message)
+	}
+	if check := CheckControllerDiskSpace(&ControllerDiskUsage{TotalGB: 64, FreeGB: 1.5}, 4); check.Passed {
+		t.Fatalf("expected check to fail")
+	}
+}
+
+func TestCheckControllerBackup(t *testing.T) {
+	now := time.Date(2022, 10, 20, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		Name     string
+		Config   CloudnBackupConfiguration
+		Expected bool
+	}{
+		{"recent backup", CloudnBackupConfiguration{BackupConfiguration: "yes", LastBackupTime: "2022-10-20 06:00:00"}, true},
+		{"recent RFC 3339 backup", CloudnBackupConfiguration{BackupConfiguration: "yes", LastBackupTime: "2022-10-20T10:00:00Z"}, true},
+		{"old backup", CloudnBackupConfiguration{BackupConfiguration: "yes", LastBackupTime: "2022-10-18 06:00:00"}, false},
+		{"no backup", CloudnBackupConfiguration{BackupConfiguration: "yes"}, false},
+		{"backups disabled", CloudnBackupConfiguration{BackupConfiguration: "no", LastBackupTime: "2022-10-20 06:00:00"}, false},
+		{"invalid backup time", CloudnBackupConfiguration{BackupConfiguration: "yes", LastBackupTime: "yesterday"}, false},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			check := CheckControllerBackup(&test.Config, now, DefaultControllerUpgradeMaxBackupAge)
+			if check.Passed != test.Expected {
+				t.Fatalf("expected passed=%t, got %t: %s", test.Expected, check.Passed, check.Message)
+			}
+		})
+	}
+}
+
+func TestControllerUpgradePreflightFailedChecks(t *testing.T) {
+	preflight := &ControllerUpgradePreflight{
+		Checks: []ControllerUpgradeCheck{
+			{Name: ControllerUpgradeCheckUpgradePath, Passed: true},
+			{Name: ControllerUpgradeCheckDiskSpace},
+			{Name: ControllerUpgradeCheckBackup},
+		},
+	}
+
+	if failed := preflight.FailedChecks(nil); len(failed) != 2 {
+		t.Fatalf("expected 2 failed checks, got %d", len(failed))
+	}
+	failed := preflight.FailedChecks([]string{ControllerUpgradeCheckBackup})
+	if len(failed) != 1 || failed[0].Name != ControllerUpgradeCheckDiskSpace {
+		t.Fatalf("expected only the disk space check to fail, got %v", failed)
+	}
+}
+
+func TestVersionMatches(t *testing.T) {
+	tt := []struct {
+		Name           string
+		CurrentVersion string
+		TargetVersion  string
+		Expected       bool
+	}{
+		{"same build", "7.0.1373", "7.0.1373", true},
+		{"same release", "7.0.1373", "7.0", true},
+		{"newer build", "7.0.1373", "7.0.1383", false},
+		{"newer release", "6.9.349", "7.0", false},
+		{"patch release", "6.5-patch.100", "6.5", false},
+		{"invalid target", "7.0.1373", "", false},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			_, current, err := ParseVersion(test.CurrentVersion)
+			if err != nil {
+				t.Fatalf("invalid current version %q: %v", test.CurrentVersion, err)
+			}
+			if got := VersionMatches(current, test.TargetVersion); got != test.Expected {
+				t.Fatalf("expected %t, got %t", test.Expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package goaviatrix
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type PingResult struct {
+	Target          string
+	PacketsSent     int
+	PacketsReceived int
+	PacketLoss      float64
+	MinLatency      float64
+	AvgLatency      float64
+	MaxLatency      float64
+	Output          string
+}
+
+// Reachable reports whether at least one ping reply was received.
+func (p *PingResult) Reachable() bool {
+	return p.PacketsReceived > 0
+}
+
+type TracerouteHop struct {
+	Hop       int
+	IP        string
+	Hostname  string
+	Latencies []float64
+	TimedOut  bool
+}
+
+type TracerouteResult struct {
+	Target  string
+	Hops    []TracerouteHop
+	Reached bool
+	Output  string
+}
+
+type TracePathHop struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	IP      string `json:"ip"`
+	Route   string `json:"route"`
+	NextHop string `json:"next_hop"`
+	Status  string `json:"status"`
+}
+
+type TracePathResult struct {
+	Reachable bool           `json:"reachable"`
+	Reason    string         `json:"reason"`
+	Hops      []TracePathHop `json:"hops"`
+}
+
+type gatewayDiagResp struct {
+	Return  bool   `json:"return"`
+	Results string `json:"results"`
+	Reason  string `json:"reason"`
+}
+
+// GatewayPing runs ping from the given gateway to the target and returns the parsed result.
+func (c *Client) GatewayPing(ctx context.Context, gwName, target string, count int) (*PingResult, error) {
+	form := map[string]string{
+		"CID":          c.CID,
+		"action":       "gateway_diag_ping",
+		"gateway_name": gwName,
+		"host_name":    target,
+		"count":        strconv.Itoa(count),
+	}
+
+	var data gatewayDiagResp
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := ParsePingOutput(data.Results)
+	if err != nil {
+		return nil, err
+	}
+	result.Target = target
+	return result, nil
+}
+
+// GatewayTraceroute runs traceroute from the given gateway to the target and returns the parsed result.
+func (c *Client) GatewayTraceroute(ctx context.Context, gwName, target string, maxHops int) (*TracerouteResult, error) {
+	form := map[string]string{
+		"CID":          c.CID,
+		"action":       "gateway_diag_traceroute",
+		"gateway_name": gwName,
+		"host_name":    target,
+		"max_hops":     strconv.Itoa(maxHops),
+	}
+
+	var data gatewayDiagResp
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := ParseTracerouteOutput(data.Results)
+	if err != nil {
+		return nil, err
+	}
+	result.Target = target
+	return result, nil
+}
+
+// GatewayTracePath returns the path traffic takes through the Aviatrix network from the source gateway
+// to the destination, which may be a gateway name or an IP address.
+func (c *Client) GatewayTracePath(ctx context.Context, srcGwName, destination string) (*TracePathResult, error) {
+	form := map[string]string{
+		"CID":          c.CID,
+		"action":       "trace_path",
+		"gateway_name": srcGwName,
+		"destination":  destination,
+	}
+
+	type TracePathResp struct {
+		Return  bool            `json:"return"`
+		Results TracePathResult `json:"results"`
+		Reason  string          `json:"reason"`
+	}
+
+	var data TracePathResp
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	return &data.Results, nil
+}
+
+var (
+	pingStatsRegex = regexp.MustCompile(`(\d+) packets transmitted, (\d+) (?:packets )?received,.*?([\d.]+)% packet loss`)
+	pingRttRegex   = regexp.MustCompile(`(?:rtt|round-trip) min/avg/max(?:/(?:mdev|stddev))? = ([\d.]+)/([\d.]+)/([\d.]+)`)
+	hopRegex       = regexp.MustCompile(`^\s*(\d+)\s+(.*)$`)
+)
+
+// ParsePingOutput parses the summary of the output of the ping command.
+func ParsePingOutput(output string) (*PingResult, error) {
+	stats := pingStatsRegex.FindStringSubmatch(output)
+	if stats == nil {
+		return nil, fmt.Errorf("could not find ping statistics in output: %q", output)
+	}
+
+	result := &PingResult{
+		Output: output,
+	}
+	result.PacketsSent, _ = strconv.Atoi(stats[1])
+	result.PacketsReceived, _ = strconv.Atoi(stats[2])
+	result.PacketLoss, _ = strconv.ParseFloat(stats[3], 64)
+
+	if rtt := pingRttRegex.FindStringSubmatch(output); rtt != nil {
+		result.MinLatency, _ = strconv.ParseFloat(rtt[1], 64)
+		result.AvgLatency, _ = strconv.ParseFloat(rtt[2], 64)
+		result.MaxLatency, _ = strconv.ParseFloat(rtt[3], 64)
+	}
+
+	return result, nil
+}
+
+// ParseTracerouteOutput parses the output of the traceroute command into a list of hops. When a hop is
+// answered by more than one address, the first one is reported.
+func ParseTracerouteOutput(output string) (*TracerouteResult, error) {
+	result := &TracerouteResult{
+		Output: output,
+	}
+
+	var destination string
+	for _, line := range strings.Split(output, "\n") {
+		if strings.HasPrefix(line, "traceroute to ") {
+			// traceroute to 8.8.8.8 (8.8.8.8), 30 hops max, 60 byte packets
+			if start, end := strings.Index(line, "("), strings.Index(line, ")"); start != -1 && end > start {
+				destination = line[start+1 : end]
+			}
+			continue
+		}
+
+		m := hopRegex.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+
+		hopNum, _ := strconv.Atoi(m[1])
+		hop := TracerouteHop{
+			Hop: hopNum,
+		}
+
+		fields := strings.Fields(m[2])
+		for i := 0; i < len(fields); i++ {
+			field := fields[i]
+			switch {
+			case field == "*":
+				continue
+			case field == "ms":
+				continue
+			case i+1 < len(fields) && fields[i+1] == "ms":
+				if latency, err := strconv.ParseFloat(field, 64); err == nil {
+					hop.Latencies = append(hop.Latencies, latency)
+				}
+			case strings.HasPrefix(field, "(") && strings.HasSuffix(field, ")"):
+				if hop.IP == "" || hop.IP == hop.Hostname {
+					hop.IP = strings.Trim(field, "()")
+				}
+			case strings.HasPrefix(field, "!"):
+				// ICMP annotations such as !H or !N
+				continue
+			default:
+				if hop.IP == "" {
+					hop.Hostname = field
+					hop.IP = field
+				}
+			}
+		}
+
+		if net.ParseIP(hop.Hostname) != nil {
+			hop.Hostname = ""
+		}
+		hop.TimedOut = hop.IP == ""
+		result.Hops = append(result.Hops, hop)
+	}
+
+	if len(result.Hops) == 0 {
+		return nil, fmt.Errorf("could not find any hops in traceroute output: %q", output)
+	}
+
+	last := result.Hops[len(result.Hops)-1]
+	result.Reached = destination != "" && last.IP == destination
+
+	return result, nil
+}
@@ -0,0 +1,95 @@
+package goaviatrix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePingOutput(t *testing.T) {
+	tt := []struct {
+		Name     string
+		Output   string
+		Expected PingResult
+		Err      bool
+	}{
+		{
+			"all replies",
+			`PING 10.0.0.1 (10.0.0.1) 56(84) bytes of data.
+64 bytes from 10.0.0.1: icmp_seq=1 ttl=64 time=1.10 ms
+64 bytes from 10.0.0.1: icmp_seq=2 ttl=64 time=1.30 ms
+
+--- 10.0.0.1 ping statistics ---
+2 packets transmitted, 2 received, 0% packet loss, time 1001ms
+rtt min/avg/max/mdev = 1.100/1.200/1.300/0.100 ms`,
+			PingResult{PacketsSent: 2, PacketsReceived: 2, PacketLoss: 0, MinLatency: 1.1, AvgLatency: 1.2, MaxLatency: 1.3},
+			false,
+		},
+		{
+			"no replies",
+			`PING 10.0.0.2 (10.0.0.2) 56(84) bytes of data.
+
+--- 10.0.0.2 ping statistics ---
+3 packets transmitted, 0 received, +3 errors, 100% packet loss, time 2003ms`,
+			PingResult{PacketsSent: 3, PacketsReceived: 0, PacketLoss: 100},
+			false,
+		},
+		{
+			"garbage",
+			"ping: unknown host",
+			PingResult{},
+			true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			result, err := ParsePingOutput(tc.Output)
+			if tc.Err {
+				if err == nil {
+					t.Fatalf("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %q", err)
+			}
+			tc.Expected.Output = tc.Output
+			if !reflect.DeepEqual(*result, tc.Expected) {
+				t.Fatalf("expected %+v, got %+v", tc.Expected, *result)
+			}
+			if result.Reachable() != (tc.Expected.PacketsReceived > 0) {
+				t.Fatalf("unexpected reachability for %+v", *result)
+			}
+		})
+	}
+}
+
+func TestParseTracerouteOutput(t *testing.T) {
+	output := `traceroute to 10.2.0.10 (10.2.0.10), 30 hops max, 60 byte packets
+ 1  10.0.0.1  0.345 ms  0.321 ms  0.300 ms
+ 2  * * *
+ 3  ip-10-1-1-1.ec2.internal (10.1.1.1)  1.234 ms  1.111 ms *
+ 4  10.2.0.10  2.000 ms !H  2.100 ms  2.200 ms`
+
+	expected := []TracerouteHop{
+		{Hop: 1, IP: "10.0.0.1", Latencies: []float64{0.345, 0.321, 0.3}},
+		{Hop: 2, TimedOut: true},
+		{Hop: 3, IP: "10.1.1.1", Hostname: "ip-10-1-1-1.ec2.internal", Latencies: []float64{1.234, 1.111}},
+		{Hop: 4, IP: "10.2.0.10", Latencies: []float64{2, 2.1, 2.2}},
+	}
+
+	result, err := ParseTracerouteOutput(output)
+	if err != nil {
+		t.Fatalf("expected no error, got %q", err)
+	}
+	if !reflect.DeepEqual(result.Hops, expected) {
+		t.Fatalf("expected hops %+v, got %+v", expected, result.Hops)
+	}
+	if !result.Reached {
+		t.Fatalf("expected destination to be reached")
+	}
+
+	if _, err := ParseTracerouteOutput("traceroute: unknown host"); err == nil {
+		t.Fatalf("expected an error for output without hops")
+	}
+}
@@ -508,6 +508,26 @@ func (c *Client) GetGateway(gateway *Gateway) (*Gateway, error) {
 	return nil, ErrNotFound
 }
 
+func (c *Client) GetGatewayList(ctx context.Context) ([]Gateway, error) {
+	action := "list_vpcs_summary"
+	params := map[string]string{
+		"CID":    c.CID,
+		"action": action,
+	}
+	var data GatewayListResp
+	err := c.GetAPIContext(ctx, &data, action, params, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	gwList := data.Results
+	for i := range gwList {
+		gw := &gwList[i]
+		gw.AllocateNewEipRead = gw.AllocateNewEipReadPtr == nil || *gw.AllocateNewEipReadPtr
+	}
+
+	return gwList, nil
+}
+
 func (c *Client) GetTransitGatewayList(ctx context.Context) ([]Gateway, error) {
 	action := "list_vpcs_summary"
 	params := map[string]string{
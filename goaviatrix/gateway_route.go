@@ -0,0 +1,99 @@
+package goaviatrix
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+type GatewayRoute struct {
+	GwName      string `json:"gw_name"`
+	Destination string `json:"destination"`
+	NextHop     string `json:"next_hop"`
+	Interface   string `json:"interface"`
+	Type        string `json:"type"`
+	Metric      int    `json:"metric"`
+	Status      string `json:"status"`
+}
+
+type BgpRoute struct {
+	Prefix  string `json:"prefix"`
+	NextHop string `json:"next_hop"`
+	AsPath  string `json:"as_path"`
+	Status  string `json:"status"`
+}
+
+// AsPathList returns the AS path as a list of AS numbers.
+func (r BgpRoute) AsPathList() []string {
+	return strings.Fields(r.AsPath)
+}
+
+type BgpConnectionRoutes struct {
+	ConnectionName   string     `json:"conn_name"`
+	GwName           string     `json:"gw_name"`
+	NeighborIP       string     `json:"neighbor_ip"`
+	NeighborAsNumber string     `json:"neighbor_asn"`
+	Status           string     `json:"status"`
+	LearnedRoutes    []BgpRoute `json:"learned_routes"`
+	AdvertisedRoutes []BgpRoute `json:"advertised_routes"`
+}
+
+// GetGatewayRouteTable returns the effective route table of a gateway and, if enabled, its HA gateway.
+func (c *Client) GetGatewayRouteTable(ctx context.Context, gwName string) ([]*GatewayRoute, error) {
+	form := map[string]string{
+		"CID":          c.CID,
+		"action":       "show_gateway_route_table",
+		"gateway_name": gwName,
+	}
+
+	type GatewayRouteTableResp struct {
+		Return  bool            `json:"return"`
+		Results []*GatewayRoute `json:"results"`
+		Reason  string          `json:"reason"`
+	}
+
+	var data GatewayRouteTableResp
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	return data.Results, nil
+}
+
+// GetTransitGatewayBgpRoutes returns the BGP learned and advertised routes of each BGP connection of a
+// transit gateway.
+func (c *Client) GetTransitGatewayBgpRoutes(ctx context.Context, gwName string) ([]*BgpConnectionRoutes, error) {
+	form := map[string]string{
+		"CID":          c.CID,
+		"action":       "list_transit_gateway_bgp_routes",
+		"gateway_name": gwName,
+	}
+
+	type BgpRoutesResp struct {
+		Return  bool                   `json:"return"`
+		Results []*BgpConnectionRoutes `json:"results"`
+		Reason  string                 `json:"reason"`
+	}
+
+	var data BgpRoutesResp
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	return data.Results, nil
+}
+
+// UniqueBgpPrefixes returns the sorted list of unique prefixes of the given routes.
+func UniqueBgpPrefixes(routes []BgpRoute) []string {
+	prefixSet := make(map[string]bool)
+	for _, route := range routes {
+		prefixSet[route.Prefix] = true
+	}
+
+	var prefixes []string
+	for prefix := range prefixSet {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	return prefixes
+}
@@ -0,0 +1,109 @@
+package goaviatrix
+
+import (
+	"context"
+	"strings"
+)
+
+// GatewayHealth is the runtime state of a gateway as reported by the controller.
+type GatewayHealth struct {
+	GwName      string `json:"gw_name"`
+	Status      string `json:"gw_status"`
+	TunnelsUp   int    `json:"tunnels_up"`
+	TunnelsDown int    `json:"tunnels_down"`
+}
+
+// Healthy returns true if the gateway is up and, when requireTunnels is set, none of its tunnels are down.
+func (h *GatewayHealth) Healthy(requireTunnels bool) bool {
+	if !strings.EqualFold(h.Status, "up") {
+		return false
+	}
+	return !requireTunnels || h.TunnelsDown == 0
+}
+
+func (c *Client) GetGatewayHealth(ctx context.Context, gwName string) (*GatewayHealth, error) {
+	form := map[string]string{
+		"CID":          c.CID,
+		"action":       "get_gateway_health",
+		"gateway_name": gwName,
+	}
+
+	var data struct {
+		Results GatewayHealth `json:"results"`
+	}
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	if data.Results.GwName == "" {
+		data.Results.GwName = gwName
+	}
+	return &data.Results, nil
+}
+
+// GatewayUpgradeTarget is a gateway to upgrade, together with its HA gateway if it has one.
+type GatewayUpgradeTarget struct {
+	GwName   string
+	HaGwName string
+}
+
+// PlanGatewayUpgradeWaves splits the targets into waves of at most maxParallel gateways.
+// Canary gateways are upgraded first, followed by the rest of the gateways. In both groups
+// the primary gateways are upgraded before the HA gateways, so a gateway and its HA peer
+// are never in the same wave.
+func PlanGatewayUpgradeWaves(targets []GatewayUpgradeTarget, canaryGwNames []string, maxParallel int) [][]string {
+	if maxParallel < 1 {
+		maxParallel = 1
+	}
+
+	var canaries, others []GatewayUpgradeTarget
+	for _, target := range targets {
+		if Contains(canaryGwNames, target.GwName) {
+			canaries = append(canaries, target)
+		} else {
+			others = append(others, target)
+		}
+	}
+
+	var waves [][]string
+	for _, group := range [][]GatewayUpgradeTarget{canaries, others} {
+		var primaries, has []string
+		for _, target := range group {
+			primaries = append(primaries, target.GwName)
+			if target.HaGwName != "" {
+				has = append(has, target.HaGwName)
+			}
+		}
+		waves = append(waves, chunkStrings(primaries, maxParallel)...)
+		waves = append(waves, chunkStrings(has, maxParallel)...)
+	}
+	return waves
+}
+
+func chunkStrings(values []string, size int) [][]string {
+	var chunks [][]string
+	for len(values) > size {
+		chunks = append(chunks, values[:size])
+		values = values[size:]
+	}
+	if len(values) > 0 {
+		chunks = append(chunks, values)
+	}
+	return chunks
+}
+
+// GatewayNeedsUpgrade returns true if the gateway's software version is older than softwareVersion,
+// or if imageVersion is set and differs from the gateway's image version.
+func GatewayNeedsUpgrade(currentSoftwareVersion, currentImageVersion, softwareVersion, imageVersion string) (bool, error) {
+	if imageVersion != "" && imageVersion != currentImageVersion {
+		return true, nil
+	}
+	if softwareVersion == "" {
+		return false, nil
+	}
+	cmp, err := CompareSoftwareVersions(currentSoftwareVersion, softwareVersion)
+	if err != nil {
+		return false, err
+	}
+	return cmp < 0, nil
+}
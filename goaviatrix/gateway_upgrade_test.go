@@ -0,0 +1,130 @@
+package goaviatrix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPlanGatewayUpgradeWaves(t *testing.T) {
+	targets := []GatewayUpgradeTarget{
+		{GwName: "spoke-1", HaGwName: "spoke-1-hagw"},
+		{GwName: "spoke-2", HaGwName: "spoke-2-hagw"},
+		{GwName: "spoke-3"},
+		{GwName: "transit-1", HaGwName: "transit-1-hagw"},
+	}
+
+	tt := []struct {
+		Name          string
+		CanaryGwNames []string
+		MaxParallel   int
+		Expected      [][]string
+	}{
+		{
+			"sequential",
+			nil,
+			1,
+			[][]string{
+				{"spoke-1"}, {"spoke-2"}, {"spoke-3"}, {"transit-1"},
+				{"spoke-1-hagw"}, {"spoke-2-hagw"}, {"transit-1-hagw"},
+			},
+		},
+		{
+			"parallel",
+			nil,
+			3,
+			[][]string{
+				{"spoke-1", "spoke-2", "spoke-3"}, {"transit-1"},
+				{"spoke-1-hagw", "spoke-2-hagw", "transit-1-hagw"},
+			},
+		},
+		{
+			"canary first",
+			[]string{"spoke-2"},
+			4,
+			[][]string{
+				{"spoke-2"}, {"spoke-2-hagw"},
+				{"spoke-1", "spoke-3", "transit-1"},
+				{"spoke-1-hagw", "transit-1-hagw"},
+			},
+		},
+		{
+			"canary without HA",
+			[]string{"spoke-3"},
+			2,
+			[][]string{
+				{"spoke-3"},
+				{"spoke-1", "spoke-2"}, {"transit-1"},
+				{"spoke-1-hagw", "spoke-2-hagw"}, {"transit-1-hagw"},
+			},
+		},
+		{
+			"invalid max parallel",
+			nil,
+			0,
+			[][]string{
+				{"spoke-1"}, {"spoke-2"}, {"spoke-3"}, {"transit-1"},
+				{"spoke-1-hagw"}, {"spoke-2-hagw"}, {"transit-1-hagw"},
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			result := PlanGatewayUpgradeWaves(targets, test.CanaryGwNames, test.MaxParallel)
+			if !reflect.DeepEqual(result, test.Expected) {
+				t.Fatalf("expected %v, got %v", test.Expected, result)
+			}
+		})
+	}
+}
+
+func TestGatewayNeedsUpgrade(t *testing.T) {
+	tt := []struct {
+		Name                   string
+		CurrentSoftwareVersion string
+		CurrentImageVersion    string
+		SoftwareVersion        string
+		ImageVersion           string
+		Expected               bool
+	}{
+		{"older software version", "6.8.1149", "hvm-cloudx-aws-022021", "7.0.1373", "", true},
+		{"same software version", "7.0.1373", "hvm-cloudx-aws-022021", "7.0.1373", "", false},
+		{"newer software version", "7.0.1373", "hvm-cloudx-aws-022021", "6.8.1149", "", false},
+		{"different image version", "7.0.1373", "hvm-cloudx-aws-022021", "7.0.1373", "hvm-cloudx-aws-102722", true},
+		{"no target", "7.0.1373", "hvm-cloudx-aws-022021", "", "", false},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			result, err := GatewayNeedsUpgrade(test.CurrentSoftwareVersion, test.CurrentImageVersion, test.SoftwareVersion, test.ImageVersion)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != test.Expected {
+				t.Fatalf("expected %t, got %t", test.Expected, result)
+			}
+		})
+	}
+}
+
+func TestGatewayHealthHealthy(t *testing.T) {
+	tt := []struct {
+		Name           string
+		Health         GatewayHealth
+		RequireTunnels bool
+		Expected       bool
+	}{
+		{"up", GatewayHealth{Status: "up", TunnelsUp: 2}, true, true},
+		{"down", GatewayHealth{Status: "down"}, false, false},
+		{"tunnel down", GatewayHealth{Status: "up", TunnelsUp: 1, TunnelsDown: 1}, true, false},
+		{"tunnel down ignored", GatewayHealth{Status: "Up", TunnelsDown: 1}, false, true},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			if result := test.Health.Healthy(test.RequireTunnels); result != test.Expected {
+				t.Fatalf("expected %t, got %t", test.Expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package goaviatrix
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+// PendingLearnedCidrs are the learned CIDRs of a transit gateway, or of one of its connections, which wait
+// for approval. ConnectionName is empty when the gateway's learned CIDRs approval mode is 'gateway'.
+type PendingLearnedCidrs struct {
+	GwName         string   `json:"gw_name"`
+	ConnectionName string   `json:"conn_name"`
+	PendingCidrs   []string `json:"pending_learned_cidrs"`
+	ApprovedCidrs  []string `json:"approved_learned_cidrs"`
+}
+
+// LearnedCidrApprovalRules approve the learned CIDRs contained in one of Supernets whose prefix length is
+// at most MaxPrefixLength. A zero MaxPrefixLength doesn't limit the prefix length.
+type LearnedCidrApprovalRules struct {
+	Supernets       []string
+	MaxPrefixLength int
+}
+
+// Classify splits cidrs into the sorted lists of CIDRs approved and rejected by the rules.
+func (r *LearnedCidrApprovalRules) Classify(cidrs []string) (approved, rejected []string, err error) {
+	var supernets []*net.IPNet
+	for _, s := range r.Supernets {
+		_, supernet, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid supernet %q: %v", s, err)
+		}
+		supernets = append(supernets, supernet)
+	}
+
+	seen := make(map[string]bool)
+	for _, cidr := range cidrs {
+		if seen[cidr] {
+			continue
+		}
+		seen[cidr] = true
+
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			rejected = append(rejected, cidr)
+			continue
+		}
+		prefixLength, _ := ipNet.Mask.Size()
+		if r.MaxPrefixLength != 0 && prefixLength > r.MaxPrefixLength {
+			rejected = append(rejected, cidr)
+			continue
+		}
+		if !cidrContainedInAny(ipNet, supernets) {
+			rejected = append(rejected, cidr)
+			continue
+		}
+		approved = append(approved, cidr)
+	}
+	sort.Strings(approved)
+	sort.Strings(rejected)
+	return approved, rejected, nil
+}
+
+func cidrContainedInAny(ipNet *net.IPNet, supernets []*net.IPNet) bool {
+	prefixLength, bits := ipNet.Mask.Size()
+	for _, supernet := range supernets {
+		supernetLength, supernetBits := supernet.Mask.Size()
+		if bits == supernetBits && supernetLength <= prefixLength && supernet.Contains(ipNet.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPendingLearnedCidrs returns the pending and approved learned CIDRs of a transit gateway, for the gateway
+// itself or for each of its connections depending on its learned CIDRs approval mode.
+func (c *Client) GetPendingLearnedCidrs(ctx context.Context, gwName string) ([]*PendingLearnedCidrs, error) {
+	form := map[string]string{
+		"CID":          c.CID,
+		"action":       "list_transit_pending_learned_cidrs",
+		"gateway_name": gwName,
+	}
+
+	var data struct {
+		Results []*PendingLearnedCidrs `json:"results"`
+	}
+	err := c.GetAPIContext(ctx, &data, form["action"], form, func(action, method, reason string, ret bool) error {
+		if !ret && strings.Contains(strings.ToLower(reason), "does not exist") {
+			return ErrNotFound
+		}
+		return BasicCheck(action, method, reason, ret)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range data.Results {
+		v.GwName = gwName
+	}
+	return data.Results, nil
+}
+
+// GetConnectionPendingLearnedCidrs returns the pending and approved learned CIDRs of a transit gateway when
+// connName is empty, or of its connection connName otherwise.
+func (c *Client) GetConnectionPendingLearnedCidrs(ctx context.Context, gwName, connName string) (*PendingLearnedCidrs, error) {
+	pendingLearnedCidrs, err := c.GetPendingLearnedCidrs(ctx, gwName)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range pendingLearnedCidrs {
+		if v.ConnectionName == connName {
+			return v, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// RejectPendingLearnedCidrs removes cidrs from the pending learned CIDRs of a transit gateway when connName is
+// empty, or of its connection connName otherwise. Rejected CIDRs are not propagated, and are pending again
+// if they are learned again.
+func (c *Client) RejectPendingLearnedCidrs(ctx context.Context, gwName, connName string, cidrs []string) error {
+	form := map[string]string{
+		"CID":            c.CID,
+		"action":         "reject_transit_pending_learned_cidrs",
+		"gateway_name":   gwName,
+		"rejected_cidrs": strings.Join(cidrs, ","),
+	}
+	if connName != "" {
+		form["connection_name"] = connName
+	}
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
+}
+
+// ApplyLearnedCidrApprovalRules approves the pending and approved learned CIDRs of a transit gateway, or of its
+// connection connName, that match the rules, and rejects the others. It returns the approved and rejected CIDRs.
+func (c *Client) ApplyLearnedCidrApprovalRules(ctx context.Context, gwName, connName string, rules *LearnedCidrApprovalRules) (approved, rejected []string, err error) {
+	current, err := c.GetConnectionPendingLearnedCidrs(ctx, gwName, connName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	approved, rejected, err = rules.Classify(append(append([]string{}, current.ApprovedCidrs...), current.PendingCidrs...))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !Equivalent(approved, current.ApprovedCidrs) {
+		if connName == "" {
+			err = c.UpdateTransitPendingApprovedCidrs(&TransitVpc{GwName: gwName, ApprovedLearnedCidrs: approved})
+		} else {
+			err = c.UpdateTransitConnectionPendingApprovedCidrs(gwName, connName, approved)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not update approved learned CIDRs: %v", err)
+		}
+	}
+	if len(rejected) != 0 {
+		if err := c.RejectPendingLearnedCidrs(ctx, gwName, connName, rejected); err != nil {
+			return nil, nil, fmt.Errorf("could not reject learned CIDRs: %v", err)
+		}
+	}
+	return approved, rejected, nil
+}
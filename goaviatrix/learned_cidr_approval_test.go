@@ -0,0 +1,78 @@
+package goaviatrix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLearnedCidrApprovalRulesClassify(t *testing.T) {
+	tt := []struct {
+		Name     string
+		Rules    LearnedCidrApprovalRules
+		Cidrs    []string
+		Approved []string
+		Rejected []string
+	}{
+		{
+			"within supernet",
+			LearnedCidrApprovalRules{Supernets: []string{"10.0.0.0/8"}},
+			[]string{"10.1.0.0/16", "192.168.0.0/24", "10.0.0.0/8"},
+			[]string{"10.0.0.0/8", "10.1.0.0/16"},
+			[]string{"192.168.0.0/24"},
+		},
+		{
+			"shorter than supernet",
+			LearnedCidrApprovalRules{Supernets: []string{"10.0.0.0/16"}},
+			[]string{"10.0.0.0/8"},
+			nil,
+			[]string{"10.0.0.0/8"},
+		},
+		{
+			"max prefix length",
+			LearnedCidrApprovalRules{Supernets: []string{"10.0.0.0/8", "172.16.0.0/12"}, MaxPrefixLength: 24},
+			[]string{"10.1.1.0/24", "10.1.1.128/25", "172.16.5.0/24"},
+			[]string{"10.1.1.0/24", "172.16.5.0/24"},
+			[]string{"10.1.1.128/25"},
+		},
+		{
+			"ipv6 cidr",
+			LearnedCidrApprovalRules{Supernets: []string{"0.0.0.0/0"}, MaxPrefixLength: 24},
+			[]string{"10.1.1.0/24", "2001:db8::/32"},
+			[]string{"10.1.1.0/24"},
+			[]string{"2001:db8::/32"},
+		},
+		{
+			"invalid and duplicate cidrs",
+			LearnedCidrApprovalRules{Supernets: []string{"0.0.0.0/0"}},
+			[]string{"10.1.1.0/24", "10.1.1.0/24", "10.1.1.0"},
+			[]string{"10.1.1.0/24"},
+			[]string{"10.1.1.0"},
+		},
+		{
+			"no supernet",
+			LearnedCidrApprovalRules{},
+			[]string{"10.1.1.0/24"},
+			nil,
+			[]string{"10.1.1.0/24"},
+		},
+	}
+
+	for _, test := range tt {
+		approved, rejected, err := test.Rules.Classify(test.Cidrs)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.Name, err)
+			continue
+		}
+		if !reflect.DeepEqual(approved, test.Approved) {
+			t.Errorf("%s: approved %v, expected %v", test.Name, approved, test.Approved)
+		}
+		if !reflect.DeepEqual(rejected, test.Rejected) {
+			t.Errorf("%s: rejected %v, expected %v", test.Name, rejected, test.Rejected)
+		}
+	}
+
+	rules := &LearnedCidrApprovalRules{Supernets: []string{"10.0.0.0"}}
+	if _, _, err := rules.Classify([]string{"10.0.0.0/8"}); err == nil {
+		t.Errorf("invalid supernet: expected an error")
+	}
+}
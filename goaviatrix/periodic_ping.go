@@ -1,12 +1,18 @@
 package goaviatrix
 
+import (
+	"strings"
+)
+
 type PeriodicPing struct {
 	Action        string `form:"action"`
 	CID           string `form:"CID"`
 	GwName        string `form:"gateway_name"`
 	Interval      string `form:"interval"`
 	IntervalAsInt int
-	IP            string `form:"ip_address"`
+	IP            string                     `form:"ip_address"`
+	IPs           []string                   `form:"-"`
+	PingResults   []PeriodicPingTargetResult `form:"-"`
 }
 
 type PeriodicPingStatusResp struct {
@@ -16,23 +22,40 @@ type PeriodicPingStatusResp struct {
 }
 
 type PeriodicPingStatusResult struct {
-	Status   string   `json:"status"`
-	IPs      []string `json:"address,omitempty"`
-	Interval int      `json:"interval,omitempty"`
+	Status      string                     `json:"status"`
+	IPs         []string                   `json:"address,omitempty"`
+	Interval    int                        `json:"interval,omitempty"`
+	PingResults []PeriodicPingTargetResult `json:"ping_results,omitempty"`
+}
+
+type PeriodicPingTargetResult struct {
+	IP           string  `json:"address"`
+	Status       string  `json:"status"`
+	Latency      float64 `json:"latency,omitempty"`
+	LastPingTime string  `json:"last_ping_time,omitempty"`
+}
+
+func (r PeriodicPingTargetResult) IsReachable() bool {
+	return r.Status == "reachable"
 }
 
 func (c *Client) CreatePeriodicPing(pp *PeriodicPing) error {
 	pp.Action = "enable_gateway_periodic_ping"
 	pp.CID = c.CID
+	if len(pp.IPs) > 0 {
+		pp.IP = strings.Join(pp.IPs, ",")
+	}
 
 	return c.PostAPI(pp.Action, pp, BasicCheck)
 }
 
-func (c *Client) GetPeriodicPing(pp *PeriodicPing) (*PeriodicPing, error) {
+// GetPeriodicPingStatus returns the periodic ping status of a gateway, including the last known
+// reachability of each target, regardless of whether periodic ping is enabled.
+func (c *Client) GetPeriodicPingStatus(gwName string) (*PeriodicPingStatusResult, error) {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "get_gateway_periodic_ping_status",
-		"gateway_name": pp.GwName,
+		"gateway_name": gwName,
 	}
 
 	var data PeriodicPingStatusResp
@@ -42,14 +65,25 @@ func (c *Client) GetPeriodicPing(pp *PeriodicPing) (*PeriodicPing, error) {
 		return nil, err
 	}
 
-	if data.Result.Status != "enabled" {
+	return &data.Result, nil
+}
+
+func (c *Client) GetPeriodicPing(pp *PeriodicPing) (*PeriodicPing, error) {
+	status, err := c.GetPeriodicPingStatus(pp.GwName)
+	if err != nil {
+		return nil, err
+	}
+
+	if status.Status != "enabled" || len(status.IPs) == 0 {
 		return nil, ErrNotFound
 	}
 
 	return &PeriodicPing{
 		GwName:        pp.GwName,
-		IntervalAsInt: data.Result.Interval,
-		IP:            data.Result.IPs[0],
+		IntervalAsInt: status.Interval,
+		IP:            status.IPs[0],
+		IPs:           status.IPs,
+		PingResults:   status.PingResults,
 	}, nil
 }
 
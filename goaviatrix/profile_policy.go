@@ -0,0 +1,276 @@
+package goaviatrix
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// profileFqdnRegexp matches FQDNs and single-label host names, optionally with a leading wildcard.
+var profileFqdnRegexp = regexp.MustCompile(`^(\*\.)?([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)*[a-zA-Z][a-zA-Z0-9-]{0,62}$`)
+
+// ProfilePolicy is a VPN profile policy compiled for client side validation and evaluation. Rules are
+// evaluated in order, the first matching rule wins and the base rule applies when no rule matches.
+type ProfilePolicy struct {
+	BaseRule string
+	Rules    []ProfileRule
+
+	compiled []compiledProfileRule
+}
+
+type compiledProfileRule struct {
+	ProfileRule
+	portFrom int
+	portTo   int
+	network  *net.IPNet
+	fqdn     string
+}
+
+// ProfileRuleShadow describes a rule that can never take effect because an earlier rule or the base
+// rule already covers all of its traffic.
+type ProfileRuleShadow struct {
+	// Index of the shadowed rule
+	Index int
+	// Index of the earlier rule covering it, -1 if it only repeats the base rule
+	ShadowedBy int
+	// Conflict is true if the covering rule has the opposite action
+	Conflict bool
+}
+
+func (s ProfileRuleShadow) String() string {
+	if s.ShadowedBy < 0 {
+		return fmt.Sprintf("rule %d has the same action as the base rule and has no effect", s.Index)
+	}
+	if s.Conflict {
+		return fmt.Sprintf("rule %d is shadowed by rule %d with the opposite action and never takes effect", s.Index, s.ShadowedBy)
+	}
+	return fmt.Sprintf("rule %d is redundant, rule %d already covers it", s.Index, s.ShadowedBy)
+}
+
+// CompileProfilePolicy validates the base rule and every policy rule, including the target and port
+// syntax, and returns the compiled policy. All invalid rules are reported in the returned error.
+func CompileProfilePolicy(baseRule string, rules []ProfileRule) (*ProfilePolicy, error) {
+	var errs []string
+	if baseRule != "" && baseRule != "allow_all" && baseRule != "deny_all" {
+		errs = append(errs, fmt.Sprintf("base rule must be 'allow_all' or 'deny_all', got %q", baseRule))
+	}
+
+	policy := &ProfilePolicy{
+		BaseRule: baseRule,
+		Rules:    rules,
+	}
+	for i, rule := range rules {
+		compiled, err := compileProfileRule(rule)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("rule %d: %v", i, err))
+			continue
+		}
+		policy.compiled = append(policy.compiled, compiled)
+	}
+
+	if len(errs) != 0 {
+		return nil, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return policy, nil
+}
+
+func compileProfileRule(rule ProfileRule) (compiledProfileRule, error) {
+	compiled := compiledProfileRule{ProfileRule: rule}
+
+	if rule.Action != "allow" && rule.Action != "deny" {
+		return compiled, fmt.Errorf("valid action is only 'allow' or 'deny'")
+	}
+	protocolDefaultValues := []string{"all", "tcp", "udp", "icmp", "sctp", "rdp", "dccp"}
+	if rule.Protocol == "" || !Contains(protocolDefaultValues, rule.Protocol) {
+		return compiled, fmt.Errorf("proto can only be one of {'all', 'tcp', 'udp', 'icmp', 'sctp', 'rdp', 'dccp'}")
+	}
+	if (rule.Protocol == "all" || rule.Protocol == "icmp") && (rule.Port != "0:65535") {
+		return compiled, fmt.Errorf("port should be '0:65535' for protocal 'all' or 'icmp'")
+	}
+
+	var err error
+	compiled.portFrom, compiled.portTo, err = parseProfilePort(rule.Port)
+	if err != nil {
+		return compiled, err
+	}
+
+	target := strings.TrimSpace(rule.Target)
+	if _, network, err := net.ParseCIDR(target); err == nil {
+		compiled.network = network
+	} else if ip := net.ParseIP(target); ip != nil {
+		bits := 128
+		if ip.To4() != nil {
+			ip, bits = ip.To4(), 32
+		}
+		compiled.network = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+	} else if profileFqdnRegexp.MatchString(target) {
+		compiled.fqdn = strings.ToLower(target)
+	} else {
+		return compiled, fmt.Errorf("target %q must be an IP address, a CIDR or an FQDN", rule.Target)
+	}
+	return compiled, nil
+}
+
+// parseProfilePort parses a single port such as "443" or a port range such as "1024:2048".
+func parseProfilePort(port string) (int, int, error) {
+	parts := strings.Split(port, ":")
+	if len(parts) > 2 {
+		return 0, 0, fmt.Errorf("port %q must be a port or a port range such as '1024:2048'", port)
+	}
+
+	var ports []int
+	for _, p := range parts {
+		n, err := strconv.Atoi(strings.TrimSpace(p))
+		if err != nil || n < 0 || n > 65535 {
+			return 0, 0, fmt.Errorf("port %q must be a port or a port range with ports in 0-65535", port)
+		}
+		ports = append(ports, n)
+	}
+	if len(ports) == 1 {
+		return ports[0], ports[0], nil
+	}
+	if ports[0] > ports[1] {
+		return 0, 0, fmt.Errorf("port range %q must not start after it ends", port)
+	}
+	return ports[0], ports[1], nil
+}
+
+// covers reports whether all traffic matched by o is also matched by r.
+func (r compiledProfileRule) covers(o compiledProfileRule) bool {
+	if r.Protocol != "all" && r.Protocol != o.Protocol {
+		return false
+	}
+	if r.portFrom > o.portFrom || r.portTo < o.portTo {
+		return false
+	}
+	if r.network != nil {
+		if o.network == nil || !r.network.Contains(o.network.IP) {
+			return false
+		}
+		rOnes, _ := r.network.Mask.Size()
+		oOnes, _ := o.network.Mask.Size()
+		return rOnes <= oOnes
+	}
+	if o.fqdn == "" {
+		return false
+	}
+	if !strings.HasPrefix(o.fqdn, "*.") {
+		return fqdnMatches(r.fqdn, o.fqdn)
+	}
+	// A wildcard is only covered by the same or a broader wildcard
+	if !strings.HasPrefix(r.fqdn, "*.") {
+		return false
+	}
+	return o.fqdn == r.fqdn || strings.HasSuffix(o.fqdn, r.fqdn[1:])
+}
+
+// overlaps reports whether some traffic is matched by both r and o.
+func (r compiledProfileRule) overlaps(o compiledProfileRule) bool {
+	if r.Protocol != "all" && o.Protocol != "all" && r.Protocol != o.Protocol {
+		return false
+	}
+	if r.portTo < o.portFrom || o.portTo < r.portFrom {
+		return false
+	}
+	if r.network != nil || o.network != nil {
+		return r.network != nil && o.network != nil && (r.network.Contains(o.network.IP) || o.network.Contains(r.network.IP))
+	}
+	if !strings.HasPrefix(o.fqdn, "*.") {
+		return fqdnMatches(r.fqdn, o.fqdn)
+	}
+	if !strings.HasPrefix(r.fqdn, "*.") {
+		return fqdnMatches(o.fqdn, r.fqdn)
+	}
+	return strings.HasSuffix(o.fqdn, r.fqdn[1:]) || strings.HasSuffix(r.fqdn, o.fqdn[1:])
+}
+
+// matches reports whether traffic to target and port with the given protocol is matched by r. The target
+// is either an IP address or an FQDN.
+func (r compiledProfileRule) matches(protocol, target string, ip net.IP, port int) bool {
+	if r.Protocol != "all" && r.Protocol != protocol {
+		return false
+	}
+	if port < r.portFrom || port > r.portTo {
+		return false
+	}
+	if r.network != nil {
+		return ip != nil && r.network.Contains(ip)
+	}
+	return ip == nil && fqdnMatches(r.fqdn, target)
+}
+
+func fqdnMatches(pattern, fqdn string) bool {
+	fqdn = strings.ToLower(fqdn)
+	if strings.HasPrefix(pattern, "*.") {
+		return strings.HasSuffix(fqdn, pattern[1:])
+	}
+	return pattern == fqdn
+}
+
+func baseRuleAction(baseRule string) string {
+	switch baseRule {
+	case "allow_all":
+		return "allow"
+	case "deny_all":
+		return "deny"
+	}
+	return ""
+}
+
+// ShadowedRules returns the rules that never take effect, either because an earlier rule matches all of
+// their traffic or because they repeat the action of the base rule and no later rule with the opposite
+// action overlaps them.
+func (p *ProfilePolicy) ShadowedRules() []ProfileRuleShadow {
+	var shadows []ProfileRuleShadow
+	for j, rule := range p.compiled {
+		shadowed := false
+		for i := 0; i < j; i++ {
+			if p.compiled[i].covers(rule) {
+				shadows = append(shadows, ProfileRuleShadow{
+					Index:      j,
+					ShadowedBy: i,
+					Conflict:   p.compiled[i].Action != rule.Action,
+				})
+				shadowed = true
+				break
+			}
+		}
+		if !shadowed && rule.Action == baseRuleAction(p.BaseRule) && !p.overriddenLater(j) {
+			shadows = append(shadows, ProfileRuleShadow{Index: j, ShadowedBy: -1})
+		}
+	}
+	return shadows
+}
+
+// overriddenLater reports whether a rule after rule j has the opposite action and overlaps it, in which
+// case rule j takes effect even if it repeats the action of the base rule.
+func (p *ProfilePolicy) overriddenLater(j int) bool {
+	for _, later := range p.compiled[j+1:] {
+		if later.Action != p.compiled[j].Action && later.overlaps(p.compiled[j]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Evaluate returns whether traffic to target and port with the given protocol is allowed, and the index
+// of the deciding rule, -1 if the base rule decides. A policy without base rule denies unmatched traffic.
+func (p *ProfilePolicy) Evaluate(protocol, target string, port int) (bool, int, error) {
+	target = strings.TrimSpace(target)
+	ip := net.ParseIP(target)
+	if ip == nil && !profileFqdnRegexp.MatchString(target) {
+		return false, -1, fmt.Errorf("target %q must be an IP address or an FQDN", target)
+	}
+	if port < 0 || port > 65535 {
+		return false, -1, fmt.Errorf("port %d must be in 0-65535", port)
+	}
+
+	for i, rule := range p.compiled {
+		if rule.matches(protocol, target, ip, port) {
+			return rule.Action == "allow", i, nil
+		}
+	}
+	return baseRuleAction(p.BaseRule) == "allow", -1, nil
+}
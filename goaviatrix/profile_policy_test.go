@@ -0,0 +1,236 @@
+package goaviatrix
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCompileProfilePolicy(t *testing.T) {
+	tt := []struct {
+		Name        string
+		BaseRule    string
+		Rules       []ProfileRule
+		ExpectedErr string
+	}{
+		{
+			"valid",
+			"deny_all",
+			[]ProfileRule{
+				{Action: "allow", Protocol: "tcp", Port: "443", Target: "10.0.0.0/16"},
+				{Action: "allow", Protocol: "udp", Port: "1024:2048", Target: "10.1.0.1"},
+				{Action: "allow", Protocol: "all", Port: "0:65535", Target: "*.example.com"},
+			},
+			"",
+		},
+		{
+			"single-label host name",
+			"deny_all",
+			[]ProfileRule{{Action: "allow", Protocol: "tcp", Port: "22", Target: "fileserver"}},
+			"",
+		},
+		{
+			"invalid base rule",
+			"allow",
+			nil,
+			"base rule must be 'allow_all' or 'deny_all'",
+		},
+		{
+			"invalid target",
+			"deny_all",
+			[]ProfileRule{{Action: "allow", Protocol: "tcp", Port: "443", Target: "10.0.0.0/33"}},
+			"rule 0: target",
+		},
+		{
+			"reversed port range",
+			"deny_all",
+			[]ProfileRule{{Action: "allow", Protocol: "tcp", Port: "443", Target: "10.0.0.0/16"}, {Action: "allow", Protocol: "tcp", Port: "2048:1024", Target: "10.0.0.0/16"}},
+			"rule 1: port range",
+		},
+		{
+			"invalid port",
+			"deny_all",
+			[]ProfileRule{{Action: "allow", Protocol: "tcp", Port: "https", Target: "10.0.0.0/16"}},
+			"rule 0: port",
+		},
+		{
+			"icmp with port",
+			"deny_all",
+			[]ProfileRule{{Action: "allow", Protocol: "icmp", Port: "443", Target: "10.0.0.0/16"}},
+			"rule 0: port should be '0:65535'",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := CompileProfilePolicy(tc.BaseRule, tc.Rules)
+			if tc.ExpectedErr == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %q", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.ExpectedErr) {
+				t.Fatalf("expected an error containing %q, got %v", tc.ExpectedErr, err)
+			}
+		})
+	}
+}
+
+func TestProfilePolicyShadowedRules(t *testing.T) {
+	policy, err := CompileProfilePolicy("deny_all", []ProfileRule{
+		{Action: "allow", Protocol: "all", Port: "0:65535", Target: "10.0.0.0/16"},
+		{Action: "deny", Protocol: "tcp", Port: "22", Target: "10.0.1.0/24"},
+		{Action: "allow", Protocol: "tcp", Port: "443", Target: "*.example.com"},
+		{Action: "allow", Protocol: "tcp", Port: "443", Target: "www.example.com"},
+		{Action: "deny", Protocol: "tcp", Port: "80", Target: "10.2.0.0/16"},
+		{Action: "allow", Protocol: "udp", Port: "53", Target: "10.1.0.0/16"},
+		{Action: "deny", Protocol: "tcp", Port: "22", Target: "10.3.1.0/24"},
+		{Action: "allow", Protocol: "all", Port: "0:65535", Target: "10.3.0.0/16"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %q", err)
+	}
+
+	expected := []ProfileRuleShadow{
+		{Index: 1, ShadowedBy: 0, Conflict: true},
+		{Index: 3, ShadowedBy: 2},
+		// Rule 6 repeats the base rule but takes effect since rule 7 allows its traffic otherwise
+		{Index: 4, ShadowedBy: -1},
+	}
+	if shadows := policy.ShadowedRules(); !reflect.DeepEqual(shadows, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, shadows)
+	}
+}
+
+func TestProfilePolicyShadowedBaseRule(t *testing.T) {
+	tt := []struct {
+		Name     string
+		Rules    []ProfileRule
+		Shadowed bool
+	}{
+		{
+			"no later rule",
+			[]ProfileRule{
+				{Action: "deny", Protocol: "tcp", Port: "22", Target: "10.0.1.0/24"},
+			},
+			true,
+		},
+		{
+			"overlapping later rule with the opposite action",
+			[]ProfileRule{
+				{Action: "deny", Protocol: "tcp", Port: "22", Target: "10.0.1.0/24"},
+				{Action: "allow", Protocol: "all", Port: "0:65535", Target: "10.0.0.0/16"},
+			},
+			false,
+		},
+		{
+			"later rule with the opposite action on other ports",
+			[]ProfileRule{
+				{Action: "deny", Protocol: "tcp", Port: "22", Target: "10.0.1.0/24"},
+				{Action: "allow", Protocol: "tcp", Port: "443", Target: "10.0.0.0/16"},
+			},
+			true,
+		},
+		{
+			"later rule with the opposite action on another network",
+			[]ProfileRule{
+				{Action: "deny", Protocol: "tcp", Port: "22", Target: "10.0.1.0/24"},
+				{Action: "allow", Protocol: "tcp", Port: "22", Target: "10.1.0.0/16"},
+			},
+			true,
+		},
+		{
+			"later wildcard with the opposite action",
+			[]ProfileRule{
+				{Action: "deny", Protocol: "tcp", Port: "443", Target: "www.example.com"},
+				{Action: "allow", Protocol: "tcp", Port: "443", Target: "*.example.com"},
+			},
+			false,
+		},
+	}
+
+	for _, test := range tt {
+		policy, err := CompileProfilePolicy("deny_all", test.Rules)
+		if err != nil {
+			t.Fatalf("%s: expected no error, got %q", test.Name, err)
+		}
+
+		shadows := policy.ShadowedRules()
+		if shadowed := len(shadows) == 1 && shadows[0].Index == 0 && shadows[0].ShadowedBy == -1; shadowed != test.Shadowed {
+			t.Errorf("%s: expected shadowed %t, got %+v", test.Name, test.Shadowed, shadows)
+		}
+	}
+}
+
+func TestProfilePolicyShadowedFqdnRules(t *testing.T) {
+	tt := []struct {
+		Name     string
+		Earlier  string
+		Later    string
+		Shadowed bool
+	}{
+		{"same exact fqdn", "www.example.com", "www.example.com", true},
+		{"exact fqdn under wildcard", "*.example.com", "www.example.com", true},
+		{"apex under wildcard", "*.example.com", "example.com", false},
+		{"different exact fqdn", "www.example.com", "api.example.com", false},
+		{"wildcard under exact fqdn", "example.com", "*.example.com", false},
+		{"repeated wildcard", "*.example.com", "*.example.com", true},
+		{"wildcard under broader wildcard", "*.example.com", "*.internal.example.com", true},
+		{"wildcard under narrower wildcard", "*.internal.example.com", "*.example.com", false},
+		{"wildcard under unrelated wildcard", "*.example.org", "*.example.com", false},
+	}
+
+	for _, test := range tt {
+		policy, err := CompileProfilePolicy("", []ProfileRule{
+			{Action: "allow", Protocol: "tcp", Port: "443", Target: test.Earlier},
+			{Action: "allow", Protocol: "tcp", Port: "443", Target: test.Later},
+		})
+		if err != nil {
+			t.Fatalf("%s: expected no error, got %q", test.Name, err)
+		}
+
+		shadows := policy.ShadowedRules()
+		if shadowed := len(shadows) == 1 && shadows[0].ShadowedBy == 0; shadowed != test.Shadowed {
+			t.Errorf("%s: expected shadowed %t, got %+v", test.Name, test.Shadowed, shadows)
+		}
+	}
+}
+
+func TestProfilePolicyEvaluate(t *testing.T) {
+	policy, err := CompileProfilePolicy("allow_all", []ProfileRule{
+		{Action: "deny", Protocol: "tcp", Port: "22", Target: "10.0.0.0/16"},
+		{Action: "deny", Protocol: "all", Port: "0:65535", Target: "*.internal.example.com"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %q", err)
+	}
+
+	tt := []struct {
+		Protocol string
+		Target   string
+		Port     int
+		Allowed  bool
+		Rule     int
+	}{
+		{"tcp", "10.0.5.5", 22, false, 0},
+		{"tcp", "10.0.5.5", 443, true, -1},
+		{"udp", "10.0.5.5", 22, true, -1},
+		{"icmp", "db.internal.example.com", 0, false, 1},
+		{"tcp", "www.example.com", 443, true, -1},
+	}
+
+	for _, tc := range tt {
+		allowed, rule, err := policy.Evaluate(tc.Protocol, tc.Target, tc.Port)
+		if err != nil {
+			t.Fatalf("expected no error for %+v, got %q", tc, err)
+		}
+		if allowed != tc.Allowed || rule != tc.Rule {
+			t.Fatalf("expected allowed %t by rule %d for %+v, got allowed %t by rule %d", tc.Allowed, tc.Rule, tc, allowed, rule)
+		}
+	}
+
+	if _, _, err := policy.Evaluate("tcp", "not a host", 22); err == nil {
+		t.Fatalf("expected an error for an invalid target")
+	}
+}
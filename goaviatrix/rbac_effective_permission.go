@@ -0,0 +1,70 @@
+package goaviatrix
+
+import (
+	"sort"
+)
+
+// RbacAllAccessAccounts is the access account name that grants access to all accounts.
+const RbacAllAccessAccounts = "all"
+
+// RbacGroupGrant is what an RBAC group grants to its users.
+type RbacGroupGrant struct {
+	GroupName      string
+	LocalLogin     bool
+	Permissions    []string
+	AccessAccounts []string
+}
+
+// EffectivePermission is a permission a user has on an account, and the RBAC groups granting it.
+type EffectivePermission struct {
+	AccountName    string
+	PermissionName string
+	GroupNames     []string
+}
+
+// EffectivePermissions combines the grants of the RBAC groups of a user into the permissions the user has on
+// each account. A group grants each of its permissions on each of its access accounts, and the 'all' access
+// account stands for every account in accountNames. The result is sorted by account and permission name.
+func EffectivePermissions(grants []RbacGroupGrant, accountNames []string) []EffectivePermission {
+	type key struct {
+		account    string
+		permission string
+	}
+	groupsByKey := make(map[key][]string)
+
+	for _, grant := range grants {
+		var accounts []string
+		for _, account := range grant.AccessAccounts {
+			if account == RbacAllAccessAccounts {
+				accounts = append(accounts, accountNames...)
+			} else {
+				accounts = append(accounts, account)
+			}
+		}
+		for _, account := range accounts {
+			for _, permission := range grant.Permissions {
+				k := key{account, permission}
+				if !Contains(groupsByKey[k], grant.GroupName) {
+					groupsByKey[k] = append(groupsByKey[k], grant.GroupName)
+				}
+			}
+		}
+	}
+
+	var result []EffectivePermission
+	for k, groupNames := range groupsByKey {
+		sort.Strings(groupNames)
+		result = append(result, EffectivePermission{
+			AccountName:    k.account,
+			PermissionName: k.permission,
+			GroupNames:     groupNames,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].AccountName != result[j].AccountName {
+			return result[i].AccountName < result[j].AccountName
+		}
+		return result[i].PermissionName < result[j].PermissionName
+	})
+	return result
+}
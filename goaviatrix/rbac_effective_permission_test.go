@@ -0,0 +1,62 @@
+package goaviatrix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEffectivePermissions(t *testing.T) {
+	accountNames := []string{"aws-prod", "aws-dev", "azure-dev"}
+
+	tt := []struct {
+		Name     string
+		Grants   []RbacGroupGrant
+		Expected []EffectivePermission
+	}{
+		{
+			"no groups",
+			nil,
+			nil,
+		},
+		{
+			"group without access accounts",
+			[]RbacGroupGrant{
+				{GroupName: "ops", Permissions: []string{"all_gateway_write"}},
+			},
+			nil,
+		},
+		{
+			"overlapping groups",
+			[]RbacGroupGrant{
+				{GroupName: "peering", Permissions: []string{"all_peering_write"}, AccessAccounts: []string{"aws-dev", "azure-dev"}},
+				{GroupName: "gateways", Permissions: []string{"all_gateway_write", "all_peering_write"}, AccessAccounts: []string{"aws-dev"}},
+			},
+			[]EffectivePermission{
+				{AccountName: "aws-dev", PermissionName: "all_gateway_write", GroupNames: []string{"gateways"}},
+				{AccountName: "aws-dev", PermissionName: "all_peering_write", GroupNames: []string{"gateways", "peering"}},
+				{AccountName: "azure-dev", PermissionName: "all_peering_write", GroupNames: []string{"peering"}},
+			},
+		},
+		{
+			"all access accounts",
+			[]RbacGroupGrant{
+				{GroupName: "admins", Permissions: []string{"all_write"}, AccessAccounts: []string{"all"}},
+				{GroupName: "dev", Permissions: []string{"all_write"}, AccessAccounts: []string{"aws-dev"}},
+			},
+			[]EffectivePermission{
+				{AccountName: "aws-dev", PermissionName: "all_write", GroupNames: []string{"admins", "dev"}},
+				{AccountName: "aws-prod", PermissionName: "all_write", GroupNames: []string{"admins"}},
+				{AccountName: "azure-dev", PermissionName: "all_write", GroupNames: []string{"admins"}},
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			result := EffectivePermissions(test.Grants, accountNames)
+			if !reflect.DeepEqual(result, test.Expected) {
+				t.Fatalf("expected %#v, got %#v", test.Expected, result)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package goaviatrix
 
 import (
+	"strings"
+
 	log "github.com/sirupsen/logrus"
 )
 
@@ -24,11 +26,11 @@ func (c *Client) CreateRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAt
 	return c.PostAPI(rbacGroupAccessAccountAttachment.Action, rbacGroupAccessAccountAttachment, BasicCheck)
 }
 
-func (c *Client) GetRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAttachment *RbacGroupAccessAccountAttachment) (*RbacGroupAccessAccountAttachment, error) {
+func (c *Client) ListRbacGroupAccessAccounts(groupName string) ([]string, error) {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "list_access_accounts_in_rbac_group",
-		"group_name": rbacGroupAccessAccountAttachment.GroupName,
+		"group_name": groupName,
 	}
 
 	var data RbacGroupAccessAccountAttachmentListResp
@@ -37,8 +39,15 @@ func (c *Client) GetRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAttac
 	if err != nil {
 		return nil, err
 	}
+	return data.RbacGroupAccessAccountAttachmentList, nil
+}
+
+func (c *Client) GetRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAttachment *RbacGroupAccessAccountAttachment) (*RbacGroupAccessAccountAttachment, error) {
+	attachments, err := c.ListRbacGroupAccessAccounts(rbacGroupAccessAccountAttachment.GroupName)
+	if err != nil {
+		return nil, err
+	}
 
-	attachments := data.RbacGroupAccessAccountAttachmentList
 	for i := range attachments {
 		if attachments[i] == rbacGroupAccessAccountAttachment.AccessAccountName {
 			log.Infof("Found Aviatrix RBAC group access account attachment: %s",
@@ -62,3 +71,21 @@ func (c *Client) DeleteRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAt
 
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
+
+// AttachRbacGroupAccessAccounts adds several access accounts to a group in a single request.
+func (c *Client) AttachRbacGroupAccessAccounts(groupName string, accountNames []string) error {
+	attachment := &RbacGroupAccessAccountAttachment{
+		GroupName:         groupName,
+		AccessAccountName: strings.Join(accountNames, ","),
+	}
+	return c.CreateRbacGroupAccessAccountAttachment(attachment)
+}
+
+// DetachRbacGroupAccessAccounts removes several access accounts from a group in a single request.
+func (c *Client) DetachRbacGroupAccessAccounts(groupName string, accountNames []string) error {
+	attachment := &RbacGroupAccessAccountAttachment{
+		GroupName:         groupName,
+		AccessAccountName: strings.Join(accountNames, ","),
+	}
+	return c.DeleteRbacGroupAccessAccountAttachment(attachment)
+}
@@ -87,7 +87,7 @@ func (c *Client) DisableLocalLoginForRBACGroup(GroupName string) error {
 	return c.PostAPI("disable_local_login", data, BasicCheck)
 }
 
-func (c *Client) GetPermissionGroupDetails(GroupName string) (*RbacGroupResponse, error) {
+func (c *Client) ListPermissionGroupDetails() ([]RbacGroupResponse, error) {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "list_permission_group_details",
@@ -99,8 +99,15 @@ func (c *Client) GetPermissionGroupDetails(GroupName string) (*RbacGroupResponse
 	if err != nil {
 		return nil, err
 	}
+	return data.RbacGroupList, nil
+}
+
+func (c *Client) GetPermissionGroupDetails(GroupName string) (*RbacGroupResponse, error) {
+	groups, err := c.ListPermissionGroupDetails()
+	if err != nil {
+		return nil, err
+	}
 
-	groups := data.RbacGroupList
 	for i := range groups {
 		if groups[i].GroupName == GroupName {
 			log.Infof("Found Aviatrix RBAC group: %s", GroupName)
@@ -32,20 +32,11 @@ func (c *Client) CreateRbacGroupPermissionAttachment(rbacGroupPermissionAttachme
 }
 
 func (c *Client) GetRbacGroupPermissionAttachment(rbacGroupPermissionAttachment *RbacGroupPermissionAttachment) (*RbacGroupPermissionAttachment, error) {
-	form := map[string]string{
-		"CID":        c.CID,
-		"action":     "list_rbac_group_permissions",
-		"group_name": rbacGroupPermissionAttachment.GroupName,
-	}
-
-	var data RbacGroupPermissionAttachmentListResp
-
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	attachments, err := c.ListRbacGroupPermissions(rbacGroupPermissionAttachment.GroupName)
 	if err != nil {
 		return nil, err
 	}
 
-	attachments := data.RbacGroupPermissionAttachmentList
 	for i := range attachments {
 		if attachments[i].Name == rbacGroupPermissionAttachment.PermissionName {
 			log.Infof("Found Aviatrix RBAC group permission attachment: %s",
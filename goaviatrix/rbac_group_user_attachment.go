@@ -1,6 +1,8 @@
 package goaviatrix
 
 import (
+	"strings"
+
 	log "github.com/sirupsen/logrus"
 )
 
@@ -24,11 +26,11 @@ func (c *Client) CreateRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGrou
 	return c.PostAPI(rbacGroupUserAttachment.Action, rbacGroupUserAttachment, BasicCheck)
 }
 
-func (c *Client) GetRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGroupUserAttachment) (*RbacGroupUserAttachment, error) {
+func (c *Client) ListRbacGroupUsers(groupName string) ([]string, error) {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "list_users_in_rbac_group",
-		"group_name": rbacGroupUserAttachment.GroupName,
+		"group_name": groupName,
 	}
 
 	var data RbacGroupUserAttachmentListResp
@@ -37,8 +39,15 @@ func (c *Client) GetRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGroupUs
 	if err != nil {
 		return nil, err
 	}
+	return data.RbacGroupUserAttachmentList, nil
+}
+
+func (c *Client) GetRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGroupUserAttachment) (*RbacGroupUserAttachment, error) {
+	attachments, err := c.ListRbacGroupUsers(rbacGroupUserAttachment.GroupName)
+	if err != nil {
+		return nil, err
+	}
 
-	attachments := data.RbacGroupUserAttachmentList
 	for i := range attachments {
 		if attachments[i] == rbacGroupUserAttachment.UserName {
 			log.Infof("Found Aviatrix RBAC group user attachment: %s",
@@ -62,3 +71,21 @@ func (c *Client) DeleteRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGrou
 
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
+
+// AttachRbacGroupUsers adds several users to a group in a single request.
+func (c *Client) AttachRbacGroupUsers(groupName string, userNames []string) error {
+	attachment := &RbacGroupUserAttachment{
+		GroupName: groupName,
+		UserName:  strings.Join(userNames, ","),
+	}
+	return c.CreateRbacGroupUserAttachment(attachment)
+}
+
+// DetachRbacGroupUsers removes several users from a group in a single request.
+func (c *Client) DetachRbacGroupUsers(groupName string, userNames []string) error {
+	attachment := &RbacGroupUserAttachment{
+		GroupName: groupName,
+		UserName:  strings.Join(userNames, ","),
+	}
+	return c.DeleteRbacGroupUserAttachment(attachment)
+}
@@ -0,0 +1,94 @@
+package goaviatrix
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// KnownRbacPermissionNames are the RBAC permissions of controllers that can't list their permissions.
+var KnownRbacPermissionNames = []string{
+	"all_dashboard_write",
+	"all_accounts_write",
+	"all_gateway_write",
+	"all_tgw_orchestrator_write",
+	"all_transit_network_write",
+	"all_firewall_network_write",
+	"all_cloudn_write",
+	"all_peering_write",
+	"all_site2cloud_write",
+	"all_openvpn_write",
+	"all_security_write",
+	"all_useful_tools_write",
+	"all_troubleshoot_write",
+	"all_write",
+}
+
+// ListRbacPermissions returns all the RBAC permissions available on the controller.
+func (c *Client) ListRbacPermissions() ([]PermissionAttachmentInfo, error) {
+	form := map[string]string{
+		"CID":    c.CID,
+		"action": "list_rbac_permissions",
+	}
+
+	var data RbacGroupPermissionAttachmentListResp
+
+	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	return data.RbacGroupPermissionAttachmentList, nil
+}
+
+// ListRbacGroupPermissions returns the RBAC permissions attached to a group.
+func (c *Client) ListRbacGroupPermissions(groupName string) ([]PermissionAttachmentInfo, error) {
+	form := map[string]string{
+		"CID":        c.CID,
+		"action":     "list_rbac_group_permissions",
+		"group_name": groupName,
+	}
+
+	var data RbacGroupPermissionAttachmentListResp
+
+	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	return data.RbacGroupPermissionAttachmentList, nil
+}
+
+// AttachRbacGroupPermissions attaches several permissions to a group in a single request.
+func (c *Client) AttachRbacGroupPermissions(groupName string, permissionNames []string) error {
+	attachment := &RbacGroupPermissionAttachment{
+		GroupName:      groupName,
+		PermissionName: strings.Join(permissionNames, ","),
+	}
+	return c.CreateRbacGroupPermissionAttachment(attachment)
+}
+
+// DetachRbacGroupPermissions detaches several permissions from a group in a single request.
+func (c *Client) DetachRbacGroupPermissions(groupName string, permissionNames []string) error {
+	attachment := &RbacGroupPermissionAttachment{
+		GroupName:      groupName,
+		PermissionName: strings.Join(permissionNames, ","),
+	}
+	return c.DeleteRbacGroupPermissionAttachment(attachment)
+}
+
+// ValidateRbacPermissionNames checks that all the permission names are in the catalog.
+func ValidateRbacPermissionNames(permissionNames []string, catalog []string) error {
+	var unknown []string
+	for _, name := range permissionNames {
+		if !Contains(catalog, name) && !Contains(unknown, name) {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	valid := append([]string{}, catalog...)
+	sort.Strings(valid)
+	return fmt.Errorf("unknown RBAC permission name(s): %s; valid permission names are: %s",
+		strings.Join(unknown, ", "), strings.Join(valid, ", "))
+}
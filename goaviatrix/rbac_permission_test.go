@@ -0,0 +1,52 @@
+package goaviatrix
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateRbacPermissionNames(t *testing.T) {
+	catalog := []string{"all_write", "all_gateway_write", "all_accounts_write"}
+
+	tt := []struct {
+		Name            string
+		PermissionNames []string
+		ExpectedError   string
+	}{
+		{
+			"no permissions",
+			nil,
+			"",
+		},
+		{
+			"known permissions",
+			[]string{"all_write", "all_gateway_write"},
+			"",
+		},
+		{
+			"unknown permissions are listed once",
+			[]string{"all_write", "all_gateway_read", "all_gateway_read", "gateway"},
+			"unknown RBAC permission name(s): all_gateway_read, gateway; valid permission names are: " +
+				"all_accounts_write, all_gateway_write, all_write",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			err := ValidateRbacPermissionNames(test.PermissionNames, catalog)
+			if test.ExpectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.ExpectedError) {
+				t.Fatalf("expected error %q, got %v", test.ExpectedError, err)
+			}
+		})
+	}
+
+	if catalog[0] != "all_write" {
+		t.Fatalf("the catalog must not be reordered")
+	}
+}
@@ -0,0 +1,192 @@
+package goaviatrix
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const maxIdpMetadataSize = 10 << 20
+
+// IdpMetadata is the information of a SAML IdP parsed from its metadata.
+type IdpMetadata struct {
+	EntityID     string
+	SSOURL       string
+	SigningCerts []IdpSigningCert
+}
+
+// IdpSigningCert is a certificate the IdP signs its SAML assertions with.
+type IdpSigningCert struct {
+	Subject           string
+	NotBefore         time.Time
+	NotAfter          time.Time
+	SHA256Fingerprint string
+}
+
+type samlEntityDescriptor struct {
+	XMLName           xml.Name
+	EntityID          string                 `xml:"entityID,attr"`
+	IDPSSODescriptors []samlIDPSSODescriptor `xml:"IDPSSODescriptor"`
+	// Only set for an EntitiesDescriptor
+	EntityDescriptors []samlEntityDescriptor `xml:"EntityDescriptor"`
+}
+
+type samlIDPSSODescriptor struct {
+	KeyDescriptors []struct {
+		Use          string   `xml:"use,attr"`
+		Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+	} `xml:"KeyDescriptor"`
+	SingleSignOnServices []struct {
+		Binding  string `xml:"Binding,attr"`
+		Location string `xml:"Location,attr"`
+	} `xml:"SingleSignOnService"`
+}
+
+// Preferred SingleSignOnService bindings, most preferred first
+var samlSSOBindings = []string{
+	"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect",
+	"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST",
+}
+
+// ParseIdpMetadata parses SAML 2.0 IdP metadata. The metadata may be an EntityDescriptor or an
+// EntitiesDescriptor, in which case the first entity with an IDPSSODescriptor is used.
+func ParseIdpMetadata(metadata []byte) (*IdpMetadata, error) {
+	var root samlEntityDescriptor
+	if err := xml.Unmarshal(metadata, &root); err != nil {
+		return nil, fmt.Errorf("invalid XML: %v", err)
+	}
+
+	var entity *samlEntityDescriptor
+	switch root.XMLName.Local {
+	case "EntityDescriptor":
+		entity = &root
+	case "EntitiesDescriptor":
+		for i := range root.EntityDescriptors {
+			if len(root.EntityDescriptors[i].IDPSSODescriptors) > 0 {
+				entity = &root.EntityDescriptors[i]
+				break
+			}
+		}
+	default:
+		return nil, fmt.Errorf("expected an EntityDescriptor or EntitiesDescriptor element, got %s", root.XMLName.Local)
+	}
+	if entity == nil || len(entity.IDPSSODescriptors) == 0 {
+		return nil, fmt.Errorf("no IDPSSODescriptor found")
+	}
+	if entity.EntityID == "" {
+		return nil, fmt.Errorf("missing entityID")
+	}
+
+	idp := entity.IDPSSODescriptors[0]
+	info := &IdpMetadata{
+		EntityID: entity.EntityID,
+	}
+
+	for _, binding := range samlSSOBindings {
+		for _, sso := range idp.SingleSignOnServices {
+			if sso.Binding == binding && info.SSOURL == "" {
+				info.SSOURL = sso.Location
+			}
+		}
+	}
+	if info.SSOURL == "" && len(idp.SingleSignOnServices) > 0 {
+		info.SSOURL = idp.SingleSignOnServices[0].Location
+	}
+	if info.SSOURL == "" {
+		return nil, fmt.Errorf("no SingleSignOnService found")
+	}
+
+	seen := make(map[string]bool)
+	for _, key := range idp.KeyDescriptors {
+		// A KeyDescriptor without use is used for both signing and encryption
+		if key.Use != "" && key.Use != "signing" {
+			continue
+		}
+		for _, encoded := range key.Certificates {
+			cert, err := parseIdpCertificate(encoded)
+			if err != nil {
+				return nil, err
+			}
+			if seen[cert.SHA256Fingerprint] {
+				continue
+			}
+			seen[cert.SHA256Fingerprint] = true
+			info.SigningCerts = append(info.SigningCerts, *cert)
+		}
+	}
+	if len(info.SigningCerts) == 0 {
+		return nil, fmt.Errorf("no signing certificate found")
+	}
+
+	return info, nil
+}
+
+func parseIdpCertificate(encoded string) (*IdpSigningCert, error) {
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encoded), ""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing certificate encoding: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing certificate: %v", err)
+	}
+
+	fingerprint := sha256.Sum256(cert.Raw)
+	return &IdpSigningCert{
+		Subject:           cert.Subject.String(),
+		NotBefore:         cert.NotBefore.UTC(),
+		NotAfter:          cert.NotAfter.UTC(),
+		SHA256Fingerprint: hex.EncodeToString(fingerprint[:]),
+	}, nil
+}
+
+// SigningCertsExpiringBefore returns the signing certificates that expire before deadline.
+func (m *IdpMetadata) SigningCertsExpiringBefore(deadline time.Time) []IdpSigningCert {
+	var certs []IdpSigningCert
+	for _, cert := range m.SigningCerts {
+		if cert.NotAfter.Before(deadline) {
+			certs = append(certs, cert)
+		}
+	}
+	return certs
+}
+
+// IdpMetadataSHA256 returns the SHA-256 hash of IdP metadata, used to detect metadata changes.
+func IdpMetadataSHA256(metadata []byte) string {
+	hash := sha256.Sum256(metadata)
+	return hex.EncodeToString(hash[:])
+}
+
+// FetchIdpMetadata downloads IdP metadata from its URL.
+func FetchIdpMetadata(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s returned status %s", url, resp.Status)
+	}
+	metadata, err := io.ReadAll(io.LimitReader(resp.Body, maxIdpMetadataSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(metadata) > maxIdpMetadataSize {
+		return nil, fmt.Errorf("IdP metadata from %s is larger than %d bytes", url, maxIdpMetadataSize)
+	}
+	return metadata, nil
+}
@@ -0,0 +1,167 @@
+package goaviatrix
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testIdpCertificate(t *testing.T, commonName string, notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(der)
+}
+
+func TestParseIdpMetadata(t *testing.T) {
+	expiry := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	cert := testIdpCertificate(t, "idp-signing", expiry)
+	nextCert := testIdpCertificate(t, "idp-signing-next", expiry.AddDate(1, 0, 0))
+
+	entity := func(keys, sso string) string {
+		return fmt.Sprintf(`<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="https://idp.example.com/metadata">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">%s%s
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`, keys, sso)
+	}
+	key := func(use, cert string) string {
+		return fmt.Sprintf(`
+    <md:KeyDescriptor use="%s"><ds:KeyInfo><ds:X509Data><ds:X509Certificate>
+      %s
+    </ds:X509Certificate></ds:X509Data></ds:KeyInfo></md:KeyDescriptor>`, use, cert)
+	}
+	sso := `
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/sso/post"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/sso/redirect"/>`
+
+	tt := []struct {
+		Name          string
+		Metadata      string
+		SSOURL        string
+		Subjects      []string
+		ExpectedError string
+	}{
+		{
+			"single signing certificate",
+			entity(key("signing", cert), sso),
+			"https://idp.example.com/sso/redirect",
+			[]string{"CN=idp-signing"},
+			"",
+		},
+		{
+			"certificate rollover with duplicate and encryption keys",
+			entity(key("signing", cert)+key("", cert)+key("signing", nextCert)+key("encryption", testIdpCertificate(t, "enc", expiry)), sso),
+			"https://idp.example.com/sso/redirect",
+			[]string{"CN=idp-signing", "CN=idp-signing-next"},
+			"",
+		},
+		{
+			"entities descriptor",
+			`<EntitiesDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata"><EntityDescriptor entityID="https://sp.example.com"/>` +
+				strings.ReplaceAll(strings.ReplaceAll(entity(key("signing", cert), sso), "md:", ""), "xmlns:md=", "xmlns:unused=") + `</EntitiesDescriptor>`,
+			"https://idp.example.com/sso/redirect",
+			[]string{"CN=idp-signing"},
+			"",
+		},
+		{
+			"invalid XML",
+			"<EntityDescriptor",
+			"",
+			nil,
+			"invalid XML",
+		},
+		{
+			"not metadata",
+			"<html></html>",
+			"",
+			nil,
+			"expected an EntityDescriptor or EntitiesDescriptor element",
+		},
+		{
+			"missing SSO service",
+			entity(key("signing", cert), ""),
+			"",
+			nil,
+			"no SingleSignOnService found",
+		},
+		{
+			"missing signing certificate",
+			entity(key("encryption", cert), sso),
+			"",
+			nil,
+			"no signing certificate found",
+		},
+		{
+			"invalid certificate",
+			entity(key("signing", "bm90IGEgY2VydGlmaWNhdGU="), sso),
+			"",
+			nil,
+			"invalid signing certificate",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			info, err := ParseIdpMetadata([]byte(test.Metadata))
+			if test.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), test.ExpectedError) {
+					t.Fatalf("expected error containing %q, got %v", test.ExpectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if info.EntityID != "https://idp.example.com/metadata" {
+				t.Fatalf("unexpected entity ID %q", info.EntityID)
+			}
+			if info.SSOURL != test.SSOURL {
+				t.Fatalf("expected SSO URL %q, got %q", test.SSOURL, info.SSOURL)
+			}
+			var subjects []string
+			for _, cert := range info.SigningCerts {
+				subjects = append(subjects, cert.Subject)
+			}
+			if strings.Join(subjects, ",") != strings.Join(test.Subjects, ",") {
+				t.Fatalf("expected signing certificates %v, got %v", test.Subjects, subjects)
+			}
+			if !info.SigningCerts[0].NotAfter.Equal(expiry) {
+				t.Fatalf("expected expiry %s, got %s", expiry, info.SigningCerts[0].NotAfter)
+			}
+		})
+	}
+}
+
+func TestSigningCertsExpiringBefore(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	info := &IdpMetadata{
+		SigningCerts: []IdpSigningCert{
+			{Subject: "CN=old", NotAfter: now.AddDate(0, 0, 10)},
+			{Subject: "CN=new", NotAfter: now.AddDate(1, 0, 0)},
+		},
+	}
+
+	expiring := info.SigningCertsExpiringBefore(now.AddDate(0, 0, 30))
+	if len(expiring) != 1 || expiring[0].Subject != "CN=old" {
+		t.Fatalf("expected only CN=old to expire, got %v", expiring)
+	}
+}
@@ -0,0 +1,154 @@
+package goaviatrix
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultBgpPollingTime       = 50
+	DefaultBgpHoldTime          = 180
+	DefaultBgpKeepaliveInterval = 60
+)
+
+// TransitGatewayBgpConfig is the gateway wide BGP configuration of a transit gateway.
+type TransitGatewayBgpConfig struct {
+	GwName         string
+	LocalASNumber  string
+	PrependASPath  []string
+	BgpEcmp        bool
+	BgpPollingTime int
+	BgpHoldTime    int
+}
+
+// BgpConnectionPolicy is the BGP configuration of a single connection of a transit gateway.
+type BgpConnectionPolicy struct {
+	GwName                     string   `json:"gw_name"`
+	ConnectionName             string   `json:"conn_name"`
+	PrependASPath              []string `json:"-"`
+	PrependASPathString        string   `json:"conn_bgp_prepend_as_path"`
+	ManualBgpAdvertisedCidrs   []string `json:"conn_bgp_manual_advertise_cidrs"`
+	KeepaliveInterval          int      `json:"bgp_keepalive_interval"`
+	HoldTime                   int      `json:"bgp_hold_time"`
+	EnableLearnedCidrsApproval bool     `json:"-"`
+	LearnedCidrsApproval       string   `json:"conn_learned_cidrs_approval"`
+	ApprovedLearnedCidrs       []string `json:"conn_approved_learned_cidrs"`
+	InboundPrefixFilter        []string `json:"conn_bgp_inbound_prefix_filter"`
+	OutboundPrefixFilter       []string `json:"conn_bgp_outbound_prefix_filter"`
+	NeighborIPs                []string `json:"neighbor_ips"`
+}
+
+type BgpNeighbor struct {
+	ConnectionName     string `json:"conn_name"`
+	GwName             string `json:"gw_name"`
+	NeighborIP         string `json:"neighbor_ip"`
+	NeighborAsNumber   string `json:"neighbor_asn"`
+	State              string `json:"state"`
+	Uptime             string `json:"uptime"`
+	PrefixesReceived   int    `json:"prefixes_received"`
+	PrefixesAdvertised int    `json:"prefixes_advertised"`
+	HoldTime           int    `json:"hold_time"`
+	KeepaliveInterval  int    `json:"keepalive_interval"`
+}
+
+// GetTransitGatewayBgpConfig returns the gateway wide BGP configuration of a transit gateway.
+func (c *Client) GetTransitGatewayBgpConfig(ctx context.Context, gwName string) (*TransitGatewayBgpConfig, error) {
+	// list_aviatrix_transit_advanced_config doesn't tell apart a deleted gateway from other errors
+	if _, err := c.GetGateway(&Gateway{GwName: gwName}); err != nil {
+		return nil, err
+	}
+
+	advancedConfig, err := c.GetTransitGatewayAdvancedConfig(&TransitVpc{GwName: gwName})
+	if err != nil {
+		return nil, err
+	}
+
+	bgpPollingTime, err := strconv.Atoi(advancedConfig.BgpPollingTime)
+	if err != nil {
+		bgpPollingTime = DefaultBgpPollingTime
+	}
+	return &TransitGatewayBgpConfig{
+		GwName:         gwName,
+		LocalASNumber:  advancedConfig.LocalASNumber,
+		PrependASPath:  advancedConfig.PrependASPath,
+		BgpEcmp:        advancedConfig.BgpEcmpEnabled,
+		BgpPollingTime: bgpPollingTime,
+		BgpHoldTime:    advancedConfig.BgpHoldTime,
+	}, nil
+}
+
+// GetBgpConnectionPolicy returns the BGP configuration of a connection of a transit gateway.
+func (c *Client) GetBgpConnectionPolicy(ctx context.Context, gwName, connName string) (*BgpConnectionPolicy, error) {
+	form := map[string]string{
+		"CID":             c.CID,
+		"action":          "get_transit_connection_bgp_config",
+		"gateway_name":    gwName,
+		"connection_name": connName,
+	}
+
+	var data struct {
+		Results BgpConnectionPolicy `json:"results"`
+	}
+	err := c.GetAPIContext(ctx, &data, form["action"], form, func(action, method, reason string, ret bool) error {
+		if !ret && strings.Contains(strings.ToLower(reason), "does not exist") {
+			return ErrNotFound
+		}
+		return BasicCheck(action, method, reason, ret)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &data.Results
+	policy.GwName = gwName
+	policy.ConnectionName = connName
+	policy.PrependASPath = strings.Fields(policy.PrependASPathString)
+	policy.EnableLearnedCidrsApproval = policy.LearnedCidrsApproval == "yes"
+	return policy, nil
+}
+
+// EditTransitConnectionBgpTimers sets the BGP keepalive interval and hold time of a connection of a transit gateway.
+func (c *Client) EditTransitConnectionBgpTimers(ctx context.Context, gwName, connName string, keepaliveInterval, holdTime int) error {
+	form := map[string]string{
+		"CID":                    c.CID,
+		"action":                 "edit_transit_connection_bgp_timers",
+		"gateway_name":           gwName,
+		"connection_name":        connName,
+		"bgp_keepalive_interval": strconv.Itoa(keepaliveInterval),
+		"bgp_hold_time":          strconv.Itoa(holdTime),
+	}
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
+}
+
+// EditTransitConnectionBgpPrefixFilters sets the prefixes a connection of a transit gateway accepts from and advertises to
+// its BGP neighbors. An empty filter accepts or advertises all the prefixes.
+func (c *Client) EditTransitConnectionBgpPrefixFilters(ctx context.Context, gwName, connName string, inbound, outbound []string) error {
+	form := map[string]string{
+		"CID":                    c.CID,
+		"action":                 "edit_transit_connection_bgp_prefix_filter",
+		"gateway_name":           gwName,
+		"connection_name":        connName,
+		"inbound_prefix_filter":  strings.Join(inbound, ","),
+		"outbound_prefix_filter": strings.Join(outbound, ","),
+	}
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
+}
+
+// GetTransitGatewayBgpNeighbors returns the state of the BGP neighbors of a transit gateway and its HA gateway.
+func (c *Client) GetTransitGatewayBgpNeighbors(ctx context.Context, gwName string) ([]*BgpNeighbor, error) {
+	form := map[string]string{
+		"CID":          c.CID,
+		"action":       "list_transit_gateway_bgp_neighbors",
+		"gateway_name": gwName,
+	}
+
+	var data struct {
+		Results []*BgpNeighbor `json:"results"`
+	}
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	return data.Results, nil
+}
@@ -2,6 +2,7 @@ package goaviatrix
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -176,3 +177,26 @@ func (c *Client) EditTransitConnectionASPathPrepend(transitGatewayPeering *Trans
 		PrependASPath:  strings.Join(prependASPath, ","),
 	}, BasicCheck)
 }
+
+// TransitPeeringMeshPairs returns the transit gateway peerings of a full mesh of the given gateways. Each peering is
+// a pair of gateway names in increasing order, and the peerings are sorted.
+func TransitPeeringMeshPairs(gateways []string) [][]string {
+	names := make([]string, 0, len(gateways))
+	seen := make(map[string]bool)
+	for _, gw := range gateways {
+		if gw == "" || seen[gw] {
+			continue
+		}
+		seen[gw] = true
+		names = append(names, gw)
+	}
+	sort.Strings(names)
+
+	var pairs [][]string
+	for i := range names {
+		for j := i + 1; j < len(names); j++ {
+			pairs = append(pairs, []string{names[i], names[j]})
+		}
+	}
+	return pairs
+}
@@ -0,0 +1,66 @@
+package goaviatrix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTransitPeeringMeshPairs(t *testing.T) {
+	tt := []struct {
+		Name     string
+		Gateways []string
+		Pairs    [][]string
+	}{
+		{
+			"single gateway",
+			[]string{"transit-a"},
+			nil,
+		},
+		{
+			"two gateways",
+			[]string{"transit-b", "transit-a"},
+			[][]string{{"transit-a", "transit-b"}},
+		},
+		{
+			"full mesh",
+			[]string{"transit-c", "transit-a", "transit-d", "transit-b"},
+			[][]string{
+				{"transit-a", "transit-b"},
+				{"transit-a", "transit-c"},
+				{"transit-a", "transit-d"},
+				{"transit-b", "transit-c"},
+				{"transit-b", "transit-d"},
+				{"transit-c", "transit-d"},
+			},
+		},
+		{
+			"duplicate and empty gateways",
+			[]string{"transit-a", "", "transit-b", "transit-a"},
+			[][]string{{"transit-a", "transit-b"}},
+		},
+	}
+
+	for _, test := range tt {
+		pairs := TransitPeeringMeshPairs(test.Gateways)
+		if !reflect.DeepEqual(pairs, test.Pairs) {
+			t.Errorf("%s: got %v, expected %v", test.Name, pairs, test.Pairs)
+		}
+	}
+}
+
+func TestTransitPeeringMeshDelta(t *testing.T) {
+	current := TransitPeeringMeshPairs([]string{"transit-a", "transit-b", "transit-c"})
+	desired := TransitPeeringMeshPairs([]string{"transit-a", "transit-c", "transit-d"})
+
+	toCreate := DifferencePairSlice(desired, current)
+	expectedToCreate := [][]string{{"transit-a", "transit-d"}, {"transit-c", "transit-d"}}
+	if !reflect.DeepEqual(toCreate, expectedToCreate) {
+		t.Errorf("peerings to create: got %v, expected %v", toCreate, expectedToCreate)
+	}
+
+	toDelete := DifferencePairSlice(current, desired)
+	expectedToDelete := [][]string{{"transit-a", "transit-b"}, {"transit-b", "transit-c"}}
+	if !reflect.DeepEqual(toDelete, expectedToDelete) {
+		t.Errorf("peerings to delete: got %v, expected %v", toDelete, expectedToDelete)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 )
 
 var ErrNotFound = fmt.Errorf("ErrNotFound")
@@ -258,3 +259,14 @@ func ValidateAttachedVPCsForCustomizedRoutes(a, b [][]string) ([][]string, [][]s
 func IsCloudType(cloudType, compare int) bool {
 	return cloudType&compare != 0
 }
+
+// ParseControllerTime parses a timestamp reported by the controller, either in RFC 3339 format or as
+// "2006-01-02 15:04:05" or "2006-01-02" in UTC.
+func ParseControllerTime(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp %q", value)
+}
@@ -1,6 +1,9 @@
 package goaviatrix
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestValidateASN(t *testing.T) {
 	tt := []struct {
@@ -48,3 +51,37 @@ func TestValidateASN(t *testing.T) {
 		})
 	}
 }
+
+func TestParseControllerTime(t *testing.T) {
+	tt := []struct {
+		Name     string
+		Input    string
+		Expected time.Time
+		Valid    bool
+	}{
+		{"rfc 3339", "2022-03-01T10:30:00Z", time.Date(2022, 3, 1, 10, 30, 0, 0, time.UTC), true},
+		{"rfc 3339 with offset", "2022-03-01T12:30:00+02:00", time.Date(2022, 3, 1, 10, 30, 0, 0, time.UTC), true},
+		{"date and time", "2022-03-01 10:30:00", time.Date(2022, 3, 1, 10, 30, 0, 0, time.UTC), true},
+		{"date", "2022-03-01", time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC), true},
+		{"empty", "", time.Time{}, false},
+		{"invalid", "03/01/2022", time.Time{}, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			got, err := ParseControllerTime(tc.Input)
+			if !tc.Valid {
+				if err == nil {
+					t.Fatalf("test case %q expected an error, got %s", tc.Name, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("test case %q expected no error, got %q", tc.Name, err)
+			}
+			if !got.Equal(tc.Expected) {
+				t.Fatalf("test case %q expected %s, got %s", tc.Name, tc.Expected, got)
+			}
+		})
+	}
+}
@@ -159,6 +159,20 @@ func (c *Client) UpgradeGateway(gateway *Gateway) error {
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
 
+// UpgradeGatewayAsync upgrades the gateway like UpgradeGateway, but as an async task whose log is
+// passed to progress while the upgrade is running.
+func (c *Client) UpgradeGatewayAsync(ctx context.Context, gateway *Gateway, progress func(string)) error {
+	form := map[string]string{
+		"action":           "upgrade_selected_gateway",
+		"CID":              c.CID,
+		"gateway_list":     gateway.GwName,
+		"software_version": gateway.SoftwareVersion,
+		"image_version":    gateway.ImageVersion,
+		"async":            "true",
+	}
+	return c.PostAsyncAPIContextWithProgress(ctx, form["action"], form, BasicCheck, progress)
+}
+
 func (c *Client) GetCurrentVersion() (string, *AviatrixVersion, error) {
 	form := map[string]string{
 		"CID":    c.CID,
@@ -0,0 +1,106 @@
+package goaviatrix
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+)
+
+// GetUsedVpcCidrs returns every CIDR known to the VPC tracker across all access accounts, including
+// secondary VPC CIDRs and GCP subnet CIDRs.
+func (c *Client) GetUsedVpcCidrs() ([]string, error) {
+	vpcList, err := c.GetVpcTracker()
+	if err != nil {
+		return nil, err
+	}
+
+	var cidrs []string
+	for _, vpc := range vpcList {
+		cidrs = append(cidrs, vpc.Cidrs...)
+		for _, subnet := range vpc.Subnets {
+			if subnet.Cidr != "" {
+				cidrs = append(cidrs, subnet.Cidr)
+			}
+		}
+	}
+	return cidrs, nil
+}
+
+// NextAvailableCidrs returns the first count blocks of the given prefix length inside supernet that do
+// not overlap any of the used CIDRs. Only IPv4 is supported. Used entries that are not valid CIDRs are
+// ignored.
+func NextAvailableCidrs(supernet string, prefixLength, count int, used []string) ([]string, error) {
+	_, superNet, err := net.ParseCIDR(supernet)
+	if err != nil {
+		return nil, fmt.Errorf("invalid supernet %q: %v", supernet, err)
+	}
+	if superNet.IP.To4() == nil {
+		return nil, fmt.Errorf("supernet %q is not an IPv4 CIDR", supernet)
+	}
+	superPrefix, _ := superNet.Mask.Size()
+	if prefixLength < superPrefix || prefixLength > 32 {
+		return nil, fmt.Errorf("prefix length %d must be between %d and 32 for supernet %q", prefixLength, superPrefix, supernet)
+	}
+
+	type ipRange struct {
+		first, last uint64
+	}
+	var usedRanges []ipRange
+	for _, cidr := range used {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil || n.IP.To4() == nil {
+			continue
+		}
+		first, last := cidrRange(n)
+		usedRanges = append(usedRanges, ipRange{first, last})
+	}
+
+	superFirst, superLast := cidrRange(superNet)
+	blockSize := uint64(1) << uint(32-prefixLength)
+
+	var cidrs []string
+	for start := superFirst; start+blockSize-1 <= superLast && len(cidrs) < count; {
+		end := start + blockSize - 1
+
+		// Skip past the end of the furthest overlapping used range, re-aligned to the block size
+		next := uint64(0)
+		for _, r := range usedRanges {
+			if r.first <= end && start <= r.last && r.last+1 > next {
+				next = r.last + 1
+			}
+		}
+		if next != 0 {
+			start = (next + blockSize - 1) / blockSize * blockSize
+			continue
+		}
+
+		ip := make(net.IP, 4)
+		binary.BigEndian.PutUint32(ip, uint32(start))
+		cidrs = append(cidrs, fmt.Sprintf("%s/%d", ip, prefixLength))
+		start += blockSize
+	}
+
+	if len(cidrs) < count {
+		return nil, fmt.Errorf("only %d free /%d blocks left in %q, %d requested", len(cidrs), prefixLength, supernet, count)
+	}
+	return cidrs, nil
+}
+
+// CidrsOverlap reports whether the two CIDRs share at least one address.
+func CidrsOverlap(a, b string) (bool, error) {
+	_, netA, err := net.ParseCIDR(a)
+	if err != nil {
+		return false, err
+	}
+	_, netB, err := net.ParseCIDR(b)
+	if err != nil {
+		return false, err
+	}
+	return netA.Contains(netB.IP) || netB.Contains(netA.IP), nil
+}
+
+func cidrRange(n *net.IPNet) (uint64, uint64) {
+	first := uint64(binary.BigEndian.Uint32(n.IP.To4()))
+	ones, _ := n.Mask.Size()
+	return first, first + (uint64(1) << uint(32-ones)) - 1
+}
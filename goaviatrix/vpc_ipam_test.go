@@ -0,0 +1,100 @@
+package goaviatrix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNextAvailableCidrs(t *testing.T) {
+	tt := []struct {
+		Name         string
+		Supernet     string
+		PrefixLength int
+		Count        int
+		Used         []string
+		Expected     []string
+		ExpectedErr  bool
+	}{
+		{
+			"empty supernet",
+			"10.0.0.0/16",
+			24,
+			2,
+			nil,
+			[]string{"10.0.0.0/24", "10.0.1.0/24"},
+			false,
+		},
+		{
+			"skip used blocks",
+			"10.0.0.0/16",
+			24,
+			2,
+			[]string{"10.0.0.0/24", "10.0.2.128/25", "192.168.0.0/16"},
+			[]string{"10.0.1.0/24", "10.0.3.0/24"},
+			false,
+		},
+		{
+			"skip larger used block",
+			"10.0.0.0/16",
+			24,
+			1,
+			[]string{"10.0.0.0/20"},
+			[]string{"10.0.16.0/24"},
+			false,
+		},
+		{
+			"used supernet overlap",
+			"10.0.0.0/16",
+			24,
+			1,
+			[]string{"10.0.0.0/8"},
+			nil,
+			true,
+		},
+		{
+			"ignore invalid used cidrs",
+			"10.0.0.0/24",
+			26,
+			4,
+			[]string{"not-a-cidr", "2001:db8::/32"},
+			[]string{"10.0.0.0/26", "10.0.0.64/26", "10.0.0.128/26", "10.0.0.192/26"},
+			false,
+		},
+		{
+			"exhausted",
+			"10.0.0.0/24",
+			25,
+			3,
+			nil,
+			nil,
+			true,
+		},
+		{
+			"prefix length shorter than supernet",
+			"10.0.0.0/24",
+			16,
+			1,
+			nil,
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			cidrs, err := NextAvailableCidrs(tc.Supernet, tc.PrefixLength, tc.Count, tc.Used)
+			if tc.ExpectedErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", cidrs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %q", err)
+			}
+			if !reflect.DeepEqual(cidrs, tc.Expected) {
+				t.Fatalf("expected %v, got %v", tc.Expected, cidrs)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ type VpcTracker struct {
 	Region        string
 	Name          string
 	Cidr          string
+	Cidrs         []string
 	InstanceCount int
 	VpcID         string
 	Subnets       []VPCTrackerSubnet
@@ -81,6 +82,7 @@ func (c *Client) GetVpcTracker() ([]*VpcTracker, error) {
 			InstanceCount: actualInstCount,
 			Subnets:       vpc.Subnets,
 			Cidr:          cidr,
+			Cidrs:         vpc.CIDRs,
 		})
 	}
 
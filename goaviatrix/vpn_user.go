@@ -3,7 +3,9 @@ package goaviatrix
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 )
 
 // VPNUser simple struct to hold vpn_user details
@@ -18,6 +20,8 @@ type VPNUser struct {
 	UserName     string   `form:"username" json:"_id,omitempty"`
 	UserEmail    string   `form:"user_email,omitempty" json:"email,omitempty"`
 	Profiles     []string `json:"profiles,omitempty"`
+	CertExpiry   string   `form:"-" json:"cert_expiry,omitempty"`
+	CertRevoked  bool     `form:"-" json:"cert_revoked,omitempty"`
 }
 
 type VPNUserResp struct {
@@ -111,3 +115,106 @@ func (c *Client) DeleteVPNUser(vpnUser *VPNUser) error {
 
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
+
+type VPNUserListResp struct {
+	Return  bool      `json:"return"`
+	Results []VPNUser `json:"results"`
+	Reason  string    `json:"reason"`
+}
+
+// ListVPNUsers returns all VPN users on the controller.
+func (c *Client) ListVPNUsers() ([]VPNUser, error) {
+	form := map[string]string{
+		"CID":    c.CID,
+		"action": "list_vpn_users",
+	}
+
+	var data VPNUserListResp
+
+	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.Results, nil
+}
+
+// ReissueVPNUserCert issues a new certificate for a VPN user and revokes the current one. The new
+// certificate is sent to the user's email, if any.
+func (c *Client) ReissueVPNUserCert(vpnUser *VPNUser) error {
+	form := map[string]string{
+		"CID":      c.CID,
+		"action":   "reissue_vpn_user_cert",
+		"username": vpnUser.UserName,
+	}
+
+	checkFunc := func(act, method, reason string, ret bool) error {
+		if !ret {
+			if strings.Contains(reason, "Sending VPN certificates to email") {
+				return nil
+			}
+			return fmt.Errorf("rest API %s %s failed: %s", act, method, reason)
+		}
+		return nil
+	}
+
+	return c.PostAPI(form["action"], form, checkFunc)
+}
+
+// RevokeVPNUserCert revokes the current certificate of a VPN user without deleting the user.
+func (c *Client) RevokeVPNUserCert(vpnUser *VPNUser) error {
+	form := map[string]string{
+		"CID":      c.CID,
+		"action":   "revoke_vpn_user_cert",
+		"username": vpnUser.UserName,
+	}
+
+	return c.PostAPI(form["action"], form, BasicCheck)
+}
+
+// CertExpiryTime parses the certificate expiry of a VPN user.
+func (vu VPNUser) CertExpiryTime() (time.Time, error) {
+	if vu.CertExpiry == "" {
+		return time.Time{}, fmt.Errorf("no certificate expiry for VPN user %s", vu.UserName)
+	}
+	t, err := ParseControllerTime(vu.CertExpiry)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid certificate expiry %q for VPN user %s", vu.CertExpiry, vu.UserName)
+	}
+	return t, nil
+}
+
+// VPNUsersWithCertExpiringBefore returns the VPN users with a valid, unrevoked certificate that expires
+// before the deadline, including already expired ones, sorted by expiry. Users whose certificate expiry
+// can't be parsed are skipped, and an error is returned for each of them.
+func VPNUsersWithCertExpiringBefore(vpnUsers []VPNUser, deadline time.Time) ([]VPNUser, []error) {
+	type expiringUser struct {
+		user   VPNUser
+		expiry time.Time
+	}
+
+	var expiring []expiringUser
+	var errs []error
+	for _, vu := range vpnUsers {
+		if vu.CertRevoked || vu.CertExpiry == "" {
+			continue
+		}
+		expiry, err := vu.CertExpiryTime()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if expiry.Before(deadline) {
+			expiring = append(expiring, expiringUser{vu, expiry})
+		}
+	}
+
+	sort.SliceStable(expiring, func(i, j int) bool {
+		return expiring[i].expiry.Before(expiring[j].expiry)
+	})
+	var result []VPNUser
+	for _, e := range expiring {
+		result = append(result, e.user)
+	}
+	return result, errs
+}
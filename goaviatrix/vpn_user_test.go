@@ -0,0 +1,41 @@
+package goaviatrix
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVPNUsersWithCertExpiringBefore(t *testing.T) {
+	vpnUsers := []VPNUser{
+		{UserName: "later", CertExpiry: "2023-03-01T00:00:00Z"},
+		{UserName: "soon", CertExpiry: "2023-01-10 12:00:00"},
+		{UserName: "expired", CertExpiry: "2022-12-01"},
+		{UserName: "revoked", CertExpiry: "2023-01-05T00:00:00Z", CertRevoked: true},
+		{UserName: "unknown"},
+	}
+
+	deadline := time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+	expiring, errs := VPNUsersWithCertExpiringBefore(vpnUsers, deadline)
+	if len(errs) != 0 {
+		t.Fatalf("expected no error, got %v", errs)
+	}
+
+	var names []string
+	for _, vu := range expiring {
+		names = append(names, vu.UserName)
+	}
+	if expected := []string{"expired", "soon"}; !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected %v, got %v", expected, names)
+	}
+
+	vpnUsers = append(vpnUsers, VPNUser{UserName: "invalid", CertExpiry: "next year"})
+	expiring, errs = VPNUsersWithCertExpiringBefore(vpnUsers, deadline)
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "invalid") {
+		t.Fatalf("expected an error for the invalid certificate expiry, got %v", errs)
+	}
+	if len(expiring) != 2 {
+		t.Fatalf("expected the other expiring users despite the invalid certificate expiry, got %v", expiring)
+	}
+}